@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAppAuthorization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appfabric_app_authorization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app", "TERRAFORMCLOUD"),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", "aws_appfabric_app_bundle.test", "arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appfabric", regexache.MustCompile(`appbundle/.+/appauthorization/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "apiKey"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.api_key_credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.oauth2_credential.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tenant.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tenant.0.tenant_display_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tenant.0.tenant_identifier", "test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credential"},
+			},
+		},
+	})
+}
+
+func testAccAppAuthorization_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appfabric_app_authorization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceAppAuthorization, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAppAuthorizationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appfabric_app_authorization" {
+				continue
+			}
+
+			_, err := tfappfabric.FindAppAuthorizationByTwoPartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["app_bundle_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFabric App Authorization %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAppAuthorizationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		_, err := tfappfabric.FindAppAuthorizationByTwoPartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["app_bundle_arn"])
+
+		return err
+	}
+}
+
+const testAccAppAuthorizationConfig_base = `
+resource "aws_appfabric_app_bundle" "test" {}
+`
+
+var testAccAppAuthorizationConfig_basic = acctest.ConfigCompose(testAccAppAuthorizationConfig_base, `
+resource "aws_appfabric_app_authorization" "test" {
+  app            = "TERRAFORMCLOUD"
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  auth_type      = "apiKey"
+
+  credential {
+    api_key_credential {
+      api_key = "exampleapikeytoken"
+    }
+  }
+
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = "test"
+  }
+}
+`)
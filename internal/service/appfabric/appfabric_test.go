@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppFabric_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"AppBundle": {
+			"basic":                 testAccAppBundle_basic,
+			"disappears":            testAccAppBundle_disappears,
+			"customerManagedKeyARN": testAccAppBundle_customerManagedKeyARN,
+			"tags":                  testAccAppBundle_tags,
+		},
+		"AppAuthorization": {
+			"basic":      testAccAppAuthorization_basic,
+			"disappears": testAccAppAuthorization_disappears,
+		},
+		"Ingestion": {
+			"basic":      testAccIngestion_basic,
+			"disappears": testAccIngestion_disappears,
+		},
+		"IngestionDestination": {
+			"basic":          testAccIngestionDestination_basic,
+			"disappears":     testAccIngestionDestination_disappears,
+			"firehoseStream": testAccIngestionDestination_firehoseStream,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccIngestionDestination_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "prefix1/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", "aws_appfabric_app_bundle.test", "arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appfabric", regexache.MustCompile(`appbundle/.+/ingestion/.+/ingestiondestination/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.firehose_stream.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.prefix", "prefix1/"),
+					resource.TestCheckResourceAttrPair(resourceName, "ingestion_arn", "aws_appfabric_ingestion.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.schema", "raw"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "prefix2/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.prefix", "prefix2/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIngestionDestination_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "prefix1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceIngestionDestination, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIngestionDestination_firehoseStream(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_firehoseStream(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.firehose_stream.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_configuration.0.audit_log.0.destination.0.firehose_stream.0.stream_name", "aws_kinesis_firehose_delivery_stream.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.format", "parquet"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.schema", "ocsf"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIngestionDestinationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appfabric_ingestion_destination" {
+				continue
+			}
+
+			_, err := tfappfabric.FindIngestionDestinationByThreePartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["ingestion_arn"], rs.Primary.Attributes["app_bundle_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFabric Ingestion Destination %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIngestionDestinationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		_, err := tfappfabric.FindIngestionDestinationByThreePartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["ingestion_arn"], rs.Primary.Attributes["app_bundle_arn"])
+
+		return err
+	}
+}
+
+func testAccIngestionDestinationConfig_basic(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccIngestionConfig_basic, fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_appfabric_ingestion_destination" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_arn  = aws_appfabric_ingestion.test.arn
+
+  processing_configuration {
+    audit_log {
+      format = "json"
+      schema = "raw"
+    }
+  }
+
+  destination_configuration {
+    audit_log {
+      destination {
+        s3_bucket {
+          bucket_name = aws_s3_bucket.test.bucket
+          prefix      = %[2]q
+        }
+      }
+    }
+  }
+}
+`, rName, prefix))
+}
+
+func testAccIngestionDestinationConfig_firehoseStream(rName string) string {
+	return acctest.ConfigCompose(testAccIngestionConfig_basic, fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "firehose.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:PutObject", "s3:GetBucketLocation", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  name        = %[1]q
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.test.arn
+    bucket_arn = aws_s3_bucket.test.arn
+  }
+
+  tags = {
+    AWSAppFabricManaged = "placeholder"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_appfabric_ingestion_destination" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_arn  = aws_appfabric_ingestion.test.arn
+
+  processing_configuration {
+    audit_log {
+      format = "parquet"
+      schema = "ocsf"
+    }
+  }
+
+  destination_configuration {
+    audit_log {
+      destination {
+        firehose_stream {
+          stream_name = aws_kinesis_firehose_delivery_stream.test.name
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
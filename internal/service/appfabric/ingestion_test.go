@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccIngestion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appfabric_ingestion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app", "TERRAFORMCLOUD"),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", "aws_appfabric_app_bundle.test", "arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appfabric", regexache.MustCompile(`appbundle/.+/ingestion/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "ingestion_type", "auditLog"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIngestion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appfabric_ingestion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceIngestion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIngestionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appfabric_ingestion" {
+				continue
+			}
+
+			_, err := tfappfabric.FindIngestionByTwoPartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["app_bundle_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFabric Ingestion %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIngestionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		_, err := tfappfabric.FindIngestionByTwoPartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["app_bundle_arn"])
+
+		return err
+	}
+}
+
+var testAccIngestionConfig_basic = acctest.ConfigCompose(testAccAppAuthorizationConfig_basic, `
+resource "aws_appfabric_ingestion" "test" {
+  app            = aws_appfabric_app_authorization.test.app
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_type = "auditLog"
+  tenant_id      = "test"
+}
+`)
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Plans")
+func newDataSourcePlans(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourcePlans{}, nil
+}
+
+const (
+	DSNamePlans = "Plans Data Source"
+)
+
+type dataSourcePlans struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourcePlans) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_backup_plans"
+}
+
+func (d *dataSourcePlans) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"include_deleted": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"plans": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[planSummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrID: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"version_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourcePlans) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().BackupConn(ctx)
+
+	var data dataSourcePlansData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &backup.ListBackupPlansInput{
+		IncludeDeleted: flex.BoolFromFramework(ctx, data.IncludeDeleted),
+	}
+
+	plans, err := findPlans(ctx, conn, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Backup, create.ErrActionReading, DSNamePlans, d.Meta().Region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var arns, ids []string
+	var summaries []*planSummaryData
+
+	for _, v := range plans {
+		arns = append(arns, aws.StringValue(v.BackupPlanArn))
+		ids = append(ids, aws.StringValue(v.BackupPlanId))
+		summaries = append(summaries, &planSummaryData{
+			ARN:       flex.StringToFramework(ctx, v.BackupPlanArn),
+			ID:        flex.StringToFramework(ctx, v.BackupPlanId),
+			Name:      flex.StringToFramework(ctx, v.BackupPlanName),
+			VersionID: flex.StringToFramework(ctx, v.VersionId),
+		})
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.ID = types.StringValue(d.Meta().Region)
+	data.IDs = flex.FlattenFrameworkStringValueList(ctx, ids)
+	data.Plans = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findPlans(ctx context.Context, conn *backup.Backup, input *backup.ListBackupPlansInput) ([]*backup.PlansListMember, error) {
+	var output []*backup.PlansListMember
+
+	err := conn.ListBackupPlansPagesWithContext(ctx, input, func(page *backup.ListBackupPlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.BackupPlansList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type dataSourcePlansData struct {
+	ARNs           types.List                                       `tfsdk:"arns"`
+	ID             types.String                                     `tfsdk:"id"`
+	IDs            types.List                                       `tfsdk:"ids"`
+	IncludeDeleted types.Bool                                       `tfsdk:"include_deleted"`
+	Plans          fwtypes.ListNestedObjectValueOf[planSummaryData] `tfsdk:"plans"`
+}
+
+type planSummaryData struct {
+	ARN       types.String `tfsdk:"arn"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	VersionID types.String `tfsdk:"version_id"`
+}
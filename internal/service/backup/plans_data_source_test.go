@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/backup"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccBackupPlansDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_backup_plans.test"
+	resourceName := "aws_backup_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlansDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "plans.*.name", resourceName, "name"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "plans.*.version_id", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPlansDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_basic(rName), `
+data "aws_backup_plans" "test" {
+  depends_on = [aws_backup_plan.test]
+}
+`)
+}
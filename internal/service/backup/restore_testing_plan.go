@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_backup_restore_testing_plan", name="Restore Testing Plan")
+// @Tags(identifierAttribute="arn")
+func ResourceRestoreTestingPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRestoreTestingPlanCreate,
+		ReadWithoutTimeout:   resourceRestoreTestingPlanRead,
+		UpdateWithoutTimeout: resourceRestoreTestingPlanUpdate,
+		DeleteWithoutTimeout: resourceRestoreTestingPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				),
+			},
+			"recovery_point_selection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(backup.RestoreTestingRecoveryPointSelectionAlgorithm_Values(), false),
+						},
+						"exclude_vaults": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"include_vaults": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"recovery_point_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(backup.RestoreTestingRecoveryPointType_Values(), false),
+							},
+						},
+						"selection_window_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 365),
+						},
+					},
+				},
+			},
+			"schedule_expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schedule_expression_timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"start_window_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 168),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRestoreTestingPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	name := d.Get("name").(string)
+	plan := &backup.RestoreTestingPlanForCreate{
+		RecoveryPointSelection: expandRestoreTestingRecoveryPointSelection(d.Get("recovery_point_selection").([]interface{})),
+		RestoreTestingPlanName: aws.String(name),
+		ScheduleExpression:     aws.String(d.Get("schedule_expression").(string)),
+	}
+
+	if v, ok := d.GetOk("schedule_expression_timezone"); ok {
+		plan.ScheduleExpressionTimezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_window_hours"); ok {
+		plan.StartWindowHours = aws.Int64(int64(v.(int)))
+	}
+
+	input := &backup.CreateRestoreTestingPlanInput{
+		CreatorRequestId:   aws.String(id.UniqueId()),
+		RestoreTestingPlan: plan,
+		Tags:               getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateRestoreTestingPlanWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Backup Restore Testing Plan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RestoreTestingPlanName))
+
+	return append(diags, resourceRestoreTestingPlanRead(ctx, d, meta)...)
+}
+
+func resourceRestoreTestingPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	plan, err := FindRestoreTestingPlanByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Restore Testing Plan %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Backup Restore Testing Plan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", plan.RestoreTestingPlanArn)
+	d.Set("name", plan.RestoreTestingPlanName)
+	if err := d.Set("recovery_point_selection", flattenRestoreTestingRecoveryPointSelection(plan.RecoveryPointSelection)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recovery_point_selection: %s", err)
+	}
+	d.Set("schedule_expression", plan.ScheduleExpression)
+	d.Set("schedule_expression_timezone", plan.ScheduleExpressionTimezone)
+	d.Set("start_window_hours", plan.StartWindowHours)
+
+	return diags
+}
+
+func resourceRestoreTestingPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		plan := &backup.RestoreTestingPlanForUpdate{
+			RecoveryPointSelection: expandRestoreTestingRecoveryPointSelection(d.Get("recovery_point_selection").([]interface{})),
+			ScheduleExpression:     aws.String(d.Get("schedule_expression").(string)),
+		}
+
+		if v, ok := d.GetOk("schedule_expression_timezone"); ok {
+			plan.ScheduleExpressionTimezone = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("start_window_hours"); ok {
+			plan.StartWindowHours = aws.Int64(int64(v.(int)))
+		}
+
+		input := &backup.UpdateRestoreTestingPlanInput{
+			RestoreTestingPlan:     plan,
+			RestoreTestingPlanName: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateRestoreTestingPlanWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Backup Restore Testing Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRestoreTestingPlanRead(ctx, d, meta)...)
+}
+
+func resourceRestoreTestingPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Backup Restore Testing Plan: %s", d.Id())
+	_, err := conn.DeleteRestoreTestingPlanWithContext(ctx, &backup.DeleteRestoreTestingPlanInput{
+		RestoreTestingPlanName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Backup Restore Testing Plan (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRestoreTestingPlanByName(ctx context.Context, conn *backup.Backup, name string) (*backup.RestoreTestingPlanForGet, error) {
+	input := &backup.GetRestoreTestingPlanInput{
+		RestoreTestingPlanName: aws.String(name),
+	}
+
+	output, err := conn.GetRestoreTestingPlanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingPlan == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingPlan, nil
+}
+
+func expandRestoreTestingRecoveryPointSelection(tfList []interface{}) *backup.RestoreTestingRecoveryPointSelection {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &backup.RestoreTestingRecoveryPointSelection{}
+
+	if v, ok := tfMap["algorithm"].(string); ok && v != "" {
+		apiObject.Algorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["exclude_vaults"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludeVaults = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["include_vaults"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.IncludeVaults = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["recovery_point_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RecoveryPointTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["selection_window_days"].(int); ok && v != 0 {
+		apiObject.SelectionWindowDays = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenRestoreTestingRecoveryPointSelection(apiObject *backup.RestoreTestingRecoveryPointSelection) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm":             aws.StringValue(apiObject.Algorithm),
+		"exclude_vaults":        flex.FlattenStringSet(apiObject.ExcludeVaults),
+		"include_vaults":        flex.FlattenStringSet(apiObject.IncludeVaults),
+		"recovery_point_types":  flex.FlattenStringSet(apiObject.RecoveryPointTypes),
+		"selection_window_days": aws.Int64Value(apiObject.SelectionWindowDays),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_backup_restore_testing_selection", name="Restore Testing Selection")
+func ResourceRestoreTestingSelection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRestoreTestingSelectionCreate,
+		ReadWithoutTimeout:   resourceRestoreTestingSelectionRead,
+		UpdateWithoutTimeout: resourceRestoreTestingSelectionUpdate,
+		DeleteWithoutTimeout: resourceRestoreTestingSelectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				),
+			},
+			"protected_resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"protected_resource_conditions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"string_equals":     restoreTestingConditionSchema(),
+						"string_not_equals": restoreTestingConditionSchema(),
+					},
+				},
+			},
+			"protected_resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"restore_metadata_overrides": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"restore_testing_plan_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"validation_window_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 168),
+			},
+		},
+	}
+}
+
+func restoreTestingConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceRestoreTestingSelectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	planName := d.Get("restore_testing_plan_name").(string)
+	name := d.Get("name").(string)
+	resourceID := restoreTestingSelectionCreateResourceID(planName, name)
+	selection := &backup.RestoreTestingSelectionForCreate{
+		IamRoleArn:                  aws.String(d.Get("iam_role_arn").(string)),
+		ProtectedResourceType:       aws.String(d.Get("protected_resource_type").(string)),
+		RestoreTestingSelectionName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("protected_resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		selection.ProtectedResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("protected_resource_conditions"); ok {
+		selection.ProtectedResourceConditions = expandProtectedResourceConditions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("restore_metadata_overrides"); ok && len(v.(map[string]interface{})) > 0 {
+		selection.RestoreMetadataOverrides = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("validation_window_hours"); ok {
+		selection.ValidationWindowHours = aws.Int64(int64(v.(int)))
+	}
+
+	input := &backup.CreateRestoreTestingSelectionInput{
+		CreatorRequestId:        aws.String(id.UniqueId()),
+		RestoreTestingPlanName:  aws.String(planName),
+		RestoreTestingSelection: selection,
+	}
+
+	// The IAM role may not be usable by AWS Backup immediately after creation.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateRestoreTestingSelectionWithContext(ctx, input)
+	}, errCodeInvalidParameterValueException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Backup Restore Testing Selection (%s): %s", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return append(diags, resourceRestoreTestingSelectionRead(ctx, d, meta)...)
+}
+
+func resourceRestoreTestingSelectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	planName, name, err := restoreTestingSelectionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	selection, err := FindRestoreTestingSelectionByTwoPartKey(ctx, conn, planName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Restore Testing Selection %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Backup Restore Testing Selection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("iam_role_arn", selection.IamRoleArn)
+	d.Set("name", selection.RestoreTestingSelectionName)
+	d.Set("protected_resource_arns", aws.StringValueSlice(selection.ProtectedResourceArns))
+	if err := d.Set("protected_resource_conditions", flattenProtectedResourceConditions(selection.ProtectedResourceConditions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting protected_resource_conditions: %s", err)
+	}
+	d.Set("protected_resource_type", selection.ProtectedResourceType)
+	d.Set("restore_metadata_overrides", aws.StringValueMap(selection.RestoreMetadataOverrides))
+	d.Set("restore_testing_plan_name", selection.RestoreTestingPlanName)
+	d.Set("validation_window_hours", selection.ValidationWindowHours)
+
+	return diags
+}
+
+func resourceRestoreTestingSelectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	planName, name, err := restoreTestingSelectionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	selection := &backup.RestoreTestingSelectionForUpdate{
+		IamRoleArn: aws.String(d.Get("iam_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("protected_resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		selection.ProtectedResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("protected_resource_conditions"); ok {
+		selection.ProtectedResourceConditions = expandProtectedResourceConditions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("restore_metadata_overrides"); ok && len(v.(map[string]interface{})) > 0 {
+		selection.RestoreMetadataOverrides = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("validation_window_hours"); ok {
+		selection.ValidationWindowHours = aws.Int64(int64(v.(int)))
+	}
+
+	input := &backup.UpdateRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelection:     selection,
+		RestoreTestingSelectionName: aws.String(name),
+	}
+
+	_, err = conn.UpdateRestoreTestingSelectionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Backup Restore Testing Selection (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRestoreTestingSelectionRead(ctx, d, meta)...)
+}
+
+func resourceRestoreTestingSelectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	planName, name, err := restoreTestingSelectionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Backup Restore Testing Selection: %s", d.Id())
+	_, err = conn.DeleteRestoreTestingSelectionWithContext(ctx, &backup.DeleteRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelectionName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Backup Restore Testing Selection (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const restoreTestingSelectionResourceIDSeparator = ":"
+
+func restoreTestingSelectionCreateResourceID(planName, name string) string {
+	parts := []string{planName, name}
+	id := strings.Join(parts, restoreTestingSelectionResourceIDSeparator)
+
+	return id
+}
+
+func restoreTestingSelectionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, restoreTestingSelectionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected RESTORE-TESTING-PLAN-NAME%[2]sNAME", id, restoreTestingSelectionResourceIDSeparator)
+}
+
+func FindRestoreTestingSelectionByTwoPartKey(ctx context.Context, conn *backup.Backup, planName, name string) (*backup.RestoreTestingSelectionForGet, error) {
+	input := &backup.GetRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelectionName: aws.String(name),
+	}
+
+	output, err := conn.GetRestoreTestingSelectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingSelection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingSelection, nil
+}
+
+func expandProtectedResourceConditions(tfList []interface{}) *backup.ProtectedResourceConditions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &backup.ProtectedResourceConditions{}
+
+	if v, ok := tfMap["string_equals"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StringEquals = expandRestoreTestingKeyValues(v)
+	}
+
+	if v, ok := tfMap["string_not_equals"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StringNotEquals = expandRestoreTestingKeyValues(v)
+	}
+
+	return apiObject
+}
+
+func expandRestoreTestingKeyValues(tfList []interface{}) []*backup.KeyValue {
+	var apiObjects []*backup.KeyValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &backup.KeyValue{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenProtectedResourceConditions(apiObject *backup.ProtectedResourceConditions) []interface{} {
+	if apiObject == nil || (len(apiObject.StringEquals) == 0 && len(apiObject.StringNotEquals) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"string_equals":     flattenRestoreTestingKeyValues(apiObject.StringEquals),
+		"string_not_equals": flattenRestoreTestingKeyValues(apiObject.StringNotEquals),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRestoreTestingKeyValues(apiObjects []*backup.KeyValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   aws.StringValue(apiObject.Key),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
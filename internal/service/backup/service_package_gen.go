@@ -16,7 +16,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourcePlans,
+			Name:    "Plans",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -82,6 +87,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceRestoreTestingPlan,
+			TypeName: "aws_backup_restore_testing_plan",
+			Name:     "Restore Testing Plan",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceRestoreTestingSelection,
+			TypeName: "aws_backup_restore_testing_selection",
+			Name:     "Restore Testing Selection",
+		},
 		{
 			Factory:  ResourceSelection,
 			TypeName: "aws_backup_selection",
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Configured table analysis rules are keyed on the configured table and the rule type.
+// Each rule type is modelled as its own resource whose ID is the configured table ID.
+
+func configuredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, resName string, ruleType types.ConfiguredTableAnalysisRuleType, policy types.ConfiguredTableAnalysisRulePolicyV1) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy: &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: policy,
+		},
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	_, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, resName, configuredTableID, err)
+	}
+
+	d.SetId(configuredTableID)
+
+	return diags
+}
+
+func configuredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, resName string, ruleType types.ConfiguredTableAnalysisRuleType, policy types.ConfiguredTableAnalysisRulePolicyV1) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy: &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: policy,
+		},
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	}
+
+	_, err := conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, resName, d.Id(), err)
+	}
+
+	return diags
+}
+
+func configuredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, resName string, ruleType types.ConfiguredTableAnalysisRuleType) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting Clean Rooms %s %s", resName, d.Id())
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, resName, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, ruleType types.ConfiguredTableAnalysisRuleType) (*types.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil || out.AnalysisRule.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
+
+// configuredTableAnalysisRulePolicyV1 unwraps the versioned policy union.
+func configuredTableAnalysisRulePolicyV1(rule *types.ConfiguredTableAnalysisRule) types.ConfiguredTableAnalysisRulePolicyV1 {
+	if v, ok := rule.Policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		return v.Value
+	}
+
+	return nil
+}
+
+func readConfiguredTableAnalysisRule(ctx context.Context, d *schema.ResourceData, meta interface{}, resName string, ruleType types.ConfiguredTableAnalysisRuleType) (*types.ConfiguredTableAnalysisRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	rule, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, d.Id(), ruleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms %s (%s) not found, removing from state", resName, d.Id())
+		d.SetId("")
+		return nil, diags
+	}
+
+	if err != nil {
+		return nil, create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, resName, d.Id(), err)
+	}
+
+	d.Set("configured_table_arn", rule.ConfiguredTableArn)
+	d.Set("configured_table_id", rule.ConfiguredTableId)
+	d.Set("create_time", rule.CreateTime.String())
+	d.Set("update_time", rule.UpdateTime.String())
+
+	return rule, diags
+}
+
+func configuredTableAnalysisRuleCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"configured_table_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"configured_table_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"create_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"update_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule_aggregation")
+func ResourceConfiguredTableAnalysisRuleAggregation() *schema.Resource {
+	s := configuredTableAnalysisRuleCommonSchema()
+	s["aggregate_columns"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column_names": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"function": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+				},
+			},
+		},
+	}
+	s["allowed_join_operators"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+		},
+	}
+	s["dimension_columns"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["join_columns"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["join_required"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+	}
+	s["output_constraints"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"minimum": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.AggregationType](),
+				},
+			},
+		},
+	}
+	s["scalar_functions"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleAggregationCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleAggregationRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleAggregationUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleAggregationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRuleAggregation = "Configured Table Analysis Rule Aggregation"
+)
+
+func resourceConfiguredTableAnalysisRuleAggregationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := configuredTableAnalysisRuleCreate(ctx, d, meta, ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation, expandAnalysisRuleAggregation(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleAggregationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleAggregationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rule, diags := readConfiguredTableAnalysisRule(ctx, d, meta, ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation)
+	if rule == nil || diags.HasError() {
+		return diags
+	}
+
+	policy, ok := configuredTableAnalysisRulePolicyV1(rule).(*types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation)
+	if !ok {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms %s (%s): unexpected policy type %T", ResNameConfiguredTableAnalysisRuleAggregation, d.Id(), rule.Policy)
+	}
+
+	v := policy.Value
+	if err := d.Set("aggregate_columns", flattenAggregateColumns(v.AggregateColumns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aggregate_columns: %s", err)
+	}
+	d.Set("allowed_join_operators", enum.Slice(v.AllowedJoinOperators...))
+	d.Set("dimension_columns", v.DimensionColumns)
+	d.Set("join_columns", v.JoinColumns)
+	d.Set("join_required", v.JoinRequired)
+	if err := d.Set("output_constraints", flattenAggregationConstraints(v.OutputConstraints)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_constraints: %s", err)
+	}
+	d.Set("scalar_functions", enum.Slice(v.ScalarFunctions...))
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleAggregationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := configuredTableAnalysisRuleUpdate(ctx, d, meta, ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation, expandAnalysisRuleAggregation(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleAggregationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleAggregationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return configuredTableAnalysisRuleDelete(ctx, d, meta, ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation)
+}
+
+func expandAnalysisRuleAggregation(d *schema.ResourceData) types.ConfiguredTableAnalysisRulePolicyV1 {
+	apiObject := types.AnalysisRuleAggregation{
+		AggregateColumns:  expandAggregateColumns(d.Get("aggregate_columns").([]interface{})),
+		DimensionColumns:  flex.ExpandStringValueSet(d.Get("dimension_columns").(*schema.Set)),
+		JoinColumns:       flex.ExpandStringValueSet(d.Get("join_columns").(*schema.Set)),
+		OutputConstraints: expandAggregationConstraints(d.Get("output_constraints").([]interface{})),
+		ScalarFunctions:   flex.ExpandStringyValueSet[types.ScalarFunctions](d.Get("scalar_functions").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("allowed_join_operators"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("join_required"); ok {
+		apiObject.JoinRequired = types.JoinRequiredOption(v.(string))
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+		Value: apiObject,
+	}
+}
+
+func expandAggregateColumns(tfList []interface{}) []types.AggregateColumn {
+	var apiObjects []types.AggregateColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AggregateColumn{
+			ColumnNames: flex.ExpandStringValueSet(tfMap["column_names"].(*schema.Set)),
+			Function:    types.AggregateFunctionName(tfMap["function"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAggregateColumns(apiObjects []types.AggregateColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"column_names": apiObject.ColumnNames,
+			"function":     string(apiObject.Function),
+		})
+	}
+
+	return tfList
+}
+
+func expandAggregationConstraints(tfList []interface{}) []types.AggregationConstraint {
+	var apiObjects []types.AggregationConstraint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AggregationConstraint{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			Minimum:    aws.Int32(int32(tfMap["minimum"].(int))),
+			Type:       types.AggregationType(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAggregationConstraints(apiObjects []types.AggregationConstraint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"column_name": aws.ToString(apiObject.ColumnName),
+			"minimum":     aws.ToInt32(apiObject.Minimum),
+			"type":        string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
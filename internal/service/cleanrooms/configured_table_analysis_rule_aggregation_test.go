@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRuleAggregation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule_aggregation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx, "aws_cleanrooms_configured_table_analysis_rule_aggregation", tfcleanrooms.ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleAggregationConfig_basic(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, tfcleanrooms.ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation, &analysisRule),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_columns.0.function", "COUNT_DISTINCT"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aggregate_columns.0.column_names.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "dimension_columns.#", "0"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "join_columns.*", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "join_required", "QUERY_RUNNER"),
+					resource.TestCheckResourceAttr(resourceName, "output_constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_constraints.0.column_name", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "output_constraints.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "output_constraints.0.type", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "scalar_functions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleAggregationConfig_basic(rName, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, tfcleanrooms.ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "output_constraints.0.minimum", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRuleAggregation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule_aggregation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx, "aws_cleanrooms_configured_table_analysis_rule_aggregation", tfcleanrooms.ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleAggregationConfig_basic(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, tfcleanrooms.ResNameConfiguredTableAnalysisRuleAggregation, types.ConfiguredTableAnalysisRuleTypeAggregation, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRuleAggregation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfiguredTableAnalysisRuleAggregationConfig_basic(rName string, minimum int) string {
+	return acctest.ConfigCompose(
+		testAccConfiguredTableConfig_basic(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, rName),
+		fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule_aggregation" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+
+  aggregate_columns {
+    column_names = ["my_column_2"]
+    function     = "COUNT_DISTINCT"
+  }
+
+  join_columns  = ["my_column_1"]
+  join_required = "QUERY_RUNNER"
+
+  output_constraints {
+    column_name = "my_column_2"
+    minimum     = %[1]d
+    type        = "COUNT_DISTINCT"
+  }
+
+  scalar_functions = ["ABS", "LOWER"]
+}
+`, minimum))
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule_custom")
+func ResourceConfiguredTableAnalysisRuleCustom() *schema.Resource {
+	s := configuredTableAnalysisRuleCommonSchema()
+	s["allowed_analyses"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["allowed_analysis_providers"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: verify.ValidAccountID,
+		},
+	}
+	s["differential_privacy"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"columns": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCustomCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleCustomRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleCustomUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleCustomDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRuleCustom = "Configured Table Analysis Rule Custom"
+)
+
+func resourceConfiguredTableAnalysisRuleCustomCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := configuredTableAnalysisRuleCreate(ctx, d, meta, ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom, expandAnalysisRuleCustom(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleCustomRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleCustomRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rule, diags := readConfiguredTableAnalysisRule(ctx, d, meta, ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom)
+	if rule == nil || diags.HasError() {
+		return diags
+	}
+
+	policy, ok := configuredTableAnalysisRulePolicyV1(rule).(*types.ConfiguredTableAnalysisRulePolicyV1MemberCustom)
+	if !ok {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms %s (%s): unexpected policy type %T", ResNameConfiguredTableAnalysisRuleCustom, d.Id(), rule.Policy)
+	}
+
+	v := policy.Value
+	d.Set("allowed_analyses", v.AllowedAnalyses)
+	d.Set("allowed_analysis_providers", v.AllowedAnalysisProviders)
+	if err := d.Set("differential_privacy", flattenDifferentialPrivacyConfiguration(v.DifferentialPrivacy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting differential_privacy: %s", err)
+	}
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleCustomUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := configuredTableAnalysisRuleUpdate(ctx, d, meta, ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom, expandAnalysisRuleCustom(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleCustomRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleCustomDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return configuredTableAnalysisRuleDelete(ctx, d, meta, ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom)
+}
+
+func expandAnalysisRuleCustom(d *schema.ResourceData) types.ConfiguredTableAnalysisRulePolicyV1 {
+	apiObject := types.AnalysisRuleCustom{
+		AllowedAnalyses: flex.ExpandStringValueSet(d.Get("allowed_analyses").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("allowed_analysis_providers"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("differential_privacy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.DifferentialPrivacy = expandDifferentialPrivacyConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyV1MemberCustom{
+		Value: apiObject,
+	}
+}
+
+func expandDifferentialPrivacyConfiguration(tfMap map[string]interface{}) *types.DifferentialPrivacyConfiguration {
+	apiObject := &types.DifferentialPrivacyConfiguration{}
+
+	for _, tfMapRaw := range tfMap["columns"].([]interface{}) {
+		column, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.Columns = append(apiObject.Columns, types.DifferentialPrivacyColumn{
+			Name: aws.String(column["name"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenDifferentialPrivacyConfiguration(apiObject *types.DifferentialPrivacyConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var columns []interface{}
+
+	for _, v := range apiObject.Columns {
+		columns = append(columns, map[string]interface{}{
+			"name": aws.ToString(v.Name),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"columns": columns,
+	}}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRuleCustom_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule_custom.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx, "aws_cleanrooms_configured_table_analysis_rule_custom", tfcleanrooms.ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleCustomConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, tfcleanrooms.ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom, &analysisRule),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_analyses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_analyses.*", "ANY_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "differential_privacy.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRuleCustom_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule_custom.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx, "aws_cleanrooms_configured_table_analysis_rule_custom", tfcleanrooms.ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleCustomConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, tfcleanrooms.ResNameConfiguredTableAnalysisRuleCustom, types.ConfiguredTableAnalysisRuleTypeCustom, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRuleCustom(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfiguredTableAnalysisRuleCustomConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccConfiguredTableConfig_basic(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, rName),
+		`
+resource "aws_cleanrooms_configured_table_analysis_rule_custom" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+
+  allowed_analyses = ["ANY_QUERY"]
+}
+`)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule_list")
+func ResourceConfiguredTableAnalysisRuleList() *schema.Resource {
+	s := configuredTableAnalysisRuleCommonSchema()
+	s["allowed_join_operators"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+		},
+	}
+	s["join_columns"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["list_columns"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleListCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleListRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleListUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleListDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRuleList = "Configured Table Analysis Rule List"
+)
+
+func resourceConfiguredTableAnalysisRuleListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := configuredTableAnalysisRuleCreate(ctx, d, meta, ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList, expandAnalysisRuleList(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleListRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rule, diags := readConfiguredTableAnalysisRule(ctx, d, meta, ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList)
+	if rule == nil || diags.HasError() {
+		return diags
+	}
+
+	policy, ok := configuredTableAnalysisRulePolicyV1(rule).(*types.ConfiguredTableAnalysisRulePolicyV1MemberList)
+	if !ok {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms %s (%s): unexpected policy type %T", ResNameConfiguredTableAnalysisRuleList, d.Id(), rule.Policy)
+	}
+
+	v := policy.Value
+	d.Set("allowed_join_operators", enum.Slice(v.AllowedJoinOperators...))
+	d.Set("join_columns", v.JoinColumns)
+	d.Set("list_columns", v.ListColumns)
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := configuredTableAnalysisRuleUpdate(ctx, d, meta, ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList, expandAnalysisRuleList(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleListRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return configuredTableAnalysisRuleDelete(ctx, d, meta, ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList)
+}
+
+func expandAnalysisRuleList(d *schema.ResourceData) types.ConfiguredTableAnalysisRulePolicyV1 {
+	apiObject := types.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringValueSet(d.Get("join_columns").(*schema.Set)),
+		ListColumns: flex.ExpandStringValueSet(d.Get("list_columns").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("allowed_join_operators"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v.(*schema.Set))
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+		Value: apiObject,
+	}
+}
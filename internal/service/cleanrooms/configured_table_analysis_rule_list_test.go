@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRuleList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx, "aws_cleanrooms_configured_table_analysis_rule_list", tfcleanrooms.ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleListConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, tfcleanrooms.ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList, &analysisRule),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "join_columns.*", "my_column_1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_join_operators.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRuleList_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx, "aws_cleanrooms_configured_table_analysis_rule_list", tfcleanrooms.ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleListConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, tfcleanrooms.ResNameConfiguredTableAnalysisRuleList, types.ConfiguredTableAnalysisRuleTypeList, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRuleList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfiguredTableAnalysisRuleListConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccConfiguredTableConfig_basic(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, rName),
+		`
+resource "aws_cleanrooms_configured_table_analysis_rule_list" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+
+  join_columns = ["my_column_1"]
+  list_columns = ["my_column_2"]
+}
+`)
+}
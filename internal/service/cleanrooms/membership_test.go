@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+	collaborationResourceName := "aws_cleanrooms_collaboration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED", TEST_TAG),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexache.MustCompile(`membership/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", collaborationResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_arn", collaborationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_creator_display_name", TEST_CREATOR_DISPLAY_NAME),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "member_abilities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "payment_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "payment_configuration.0.query_compute.0.is_responsible", "true"),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED", TEST_TAG),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_mutableProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED", TEST_TAG),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+				),
+			},
+			{
+				Config: testAccMembershipConfig_basic(rName, "ENABLED", "updated tag"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipIsTheSame(resourceName, &membership),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", "updated tag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_defaultResultConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_defaultResultConfiguration(rName, "CSV"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.key_prefix", "results/"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "CSV"),
+				),
+			},
+			{
+				Config: testAccMembershipConfig_defaultResultConfiguration(rName, "PARQUET"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipIsTheSame(resourceName, &membership),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "PARQUET"),
+				),
+			},
+			{
+				Config: testAccMembershipConfig_defaultResultConfigurationRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipIsTheSame(resourceName, &membership),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "PARQUET"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_membership" {
+				continue
+			}
+
+			out, err := conn.GetMembership(ctx, &cleanrooms.GetMembershipInput{
+				MembershipIdentifier: aws.String(rs.Primary.ID),
+			})
+
+			if err == nil && out.Membership.Status != types.MembershipStatusRemoved {
+				return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMembershipExists(ctx context.Context, name string, membership *cleanrooms.GetMembershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not set"))
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := client.GetMembership(ctx, &cleanrooms.GetMembershipInput{
+			MembershipIdentifier: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, rs.Primary.ID, err)
+		}
+
+		*membership = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckMembershipIsTheSame(name string, membership *cleanrooms.GetMembershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID != aws.ToString(membership.Membership.Id) {
+			return fmt.Errorf("New membership: %s created instead of updating: %s", rs.Primary.ID, aws.ToString(membership.Membership.Id))
+		}
+
+		return nil
+	}
+}
+
+func testAccMembershipConfig_basic(rName string, queryLogStatus string, tagValue string) string {
+	return acctest.ConfigCompose(
+		testAccCollaborationConfig_basic(rName, TEST_DESCRIPTION, TEST_TAG),
+		fmt.Sprintf(`
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = %[1]q
+
+  tags = {
+    Project = %[2]q
+  }
+}
+`, queryLogStatus, tagValue))
+}
+
+func testAccMembershipConfig_defaultResultConfiguration(rName string, resultFormat string) string {
+	return acctest.ConfigCompose(
+		testAccCollaborationConfig_basic(rName, TEST_DESCRIPTION, TEST_TAG),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = "DISABLED"
+
+  default_result_configuration {
+    output_configuration {
+      s3 {
+        bucket        = aws_s3_bucket.test.bucket
+        key_prefix    = "results/"
+        result_format = %[2]q
+      }
+    }
+  }
+}
+`, rName, resultFormat))
+}
+
+func testAccMembershipConfig_defaultResultConfigurationRemoved(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCollaborationConfig_basic(rName, TEST_DESCRIPTION, TEST_TAG),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = "DISABLED"
+}
+`, rName))
+}
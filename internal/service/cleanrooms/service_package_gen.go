@@ -42,6 +42,32 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceConfiguredTableAnalysisRuleAggregation,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule_aggregation",
+		},
+		{
+			Factory:  ResourceConfiguredTableAnalysisRuleCustom,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule_custom",
+		},
+		{
+			Factory:  ResourceConfiguredTableAnalysisRuleList,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule_list",
+		},
+		{
+			Factory:  ResourceConfiguredTableAssociation,
+			TypeName: "aws_cleanrooms_configured_table_association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceMembership,
+			TypeName: "aws_cleanrooms_membership",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 	}
 }
 
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnrollmentStatus_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v computeoptimizer.GetEnrollmentStatusOutput
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic("Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "number_of_member_accounts_opted_in"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_basic("Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnrollmentStatus_includeMemberAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v computeoptimizer.GetEnrollmentStatusOutput
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnrollmentStatusExists(ctx context.Context, n string, v *computeoptimizer.GetEnrollmentStatusOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		output, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+	_, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccEnrollmentStatusConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = %[1]q
+}
+`, status)
+}
+
+func testAccEnrollmentStatusConfig_includeMemberAccounts(includeMemberAccounts bool) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status                  = "Active"
+  include_member_accounts = %[1]t
+}
+`, includeMemberAccounts)
+}
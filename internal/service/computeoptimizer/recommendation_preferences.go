@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Recommendation Preferences")
+func newRecommendationPreferencesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recommendationPreferencesResource{}
+
+	return r, nil
+}
+
+type recommendationPreferencesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *recommendationPreferencesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendation_preferences"
+}
+
+func (r *recommendationPreferencesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enhanced_infrastructure_metrics": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnhancedInfrastructureMetrics](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"inferred_workload_types": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InferredWorkloadTypesPreference](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"external_metrics_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[externalMetricsPreferenceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ExternalMetricsSource](),
+							Required:   true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"scope": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scopeModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ScopeName](),
+							Required:   true,
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"utilization_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[utilizationPreferenceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"metric_name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CustomizableMetricName](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"metric_parameters": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customizableMetricParametersModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"headroom": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CustomizableMetricHeadroom](),
+										Required:   true,
+									},
+									"threshold": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CustomizableMetricThreshold](),
+										Optional:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recommendationPreferencesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recommendationPreferencesResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.PutRecommendationPreferencesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Compute Optimizer Recommendation Preferences", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID(ctx)
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, data.ResourceType.ValueString(), input.Scope.Name, aws.ToString(input.Scope.Value))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.EnhancedInfrastructureMetrics = fwtypes.StringEnumValue(output.EnhancedInfrastructureMetrics)
+	data.InferredWorkloadTypes = fwtypes.StringEnumValue(output.InferredWorkloadTypes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommendationPreferencesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recommendationPreferencesResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), recommendationPreferencesResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, parts[0], awstypes.ScopeName(parts[1]), parts[2])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommendationPreferencesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recommendationPreferencesResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.PutRecommendationPreferencesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Preferences omitted from a put request are left unchanged, so any removed blocks must be explicitly deleted.
+	var preferenceNames []awstypes.RecommendationPreferenceName
+	if !old.ExternalMetricsPreference.IsNull() && new.ExternalMetricsPreference.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameExternalMetricsPreference)
+	}
+	if !old.UtilizationPreferences.IsNull() && new.UtilizationPreferences.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameUtilizationPreferences)
+	}
+
+	if len(preferenceNames) > 0 {
+		input := &computeoptimizer.DeleteRecommendationPreferencesInput{
+			RecommendationPreferenceNames: preferenceNames,
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.DeleteRecommendationPreferences(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recommendationPreferencesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recommendationPreferencesResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.DeleteRecommendationPreferencesInput{
+		RecommendationPreferenceNames: enum.EnumValues[awstypes.RecommendationPreferenceName](),
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteRecommendationPreferences(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findRecommendationPreferencesByThreePartKey(ctx context.Context, conn *computeoptimizer.Client, resourceType string, scopeName awstypes.ScopeName, scopeValue string) (*awstypes.RecommendationPreferencesDetail, error) {
+	input := &computeoptimizer.GetRecommendationPreferencesInput{
+		ResourceType: awstypes.ResourceType(resourceType),
+		Scope: &awstypes.Scope{
+			Name:  scopeName,
+			Value: aws.String(scopeValue),
+		},
+	}
+
+	return findRecommendationPreferencesDetail(ctx, conn, input, func(v *awstypes.RecommendationPreferencesDetail) bool {
+		if v.Scope == nil {
+			return false
+		}
+
+		return v.Scope.Name == scopeName && aws.ToString(v.Scope.Value) == scopeValue
+	})
+}
+
+func findRecommendationPreferencesDetail(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetRecommendationPreferencesInput, filter tfslices.Predicate[*awstypes.RecommendationPreferencesDetail]) (*awstypes.RecommendationPreferencesDetail, error) {
+	output, err := findRecommendationPreferencesDetails(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findRecommendationPreferencesDetails(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetRecommendationPreferencesInput, filter tfslices.Predicate[*awstypes.RecommendationPreferencesDetail]) ([]*awstypes.RecommendationPreferencesDetail, error) {
+	var output []*awstypes.RecommendationPreferencesDetail
+
+	pages := computeoptimizer.NewGetRecommendationPreferencesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RecommendationPreferencesDetails {
+			v := v
+			if v := &v; filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type recommendationPreferencesResourceModel struct {
+	EnhancedInfrastructureMetrics fwtypes.StringEnum[awstypes.EnhancedInfrastructureMetrics]      `tfsdk:"enhanced_infrastructure_metrics"`
+	ExternalMetricsPreference     fwtypes.ListNestedObjectValueOf[externalMetricsPreferenceModel] `tfsdk:"external_metrics_preference"`
+	ID                            types.String                                                    `tfsdk:"id"`
+	InferredWorkloadTypes         fwtypes.StringEnum[awstypes.InferredWorkloadTypesPreference]    `tfsdk:"inferred_workload_types"`
+	ResourceType                  fwtypes.StringEnum[awstypes.ResourceType]                       `tfsdk:"resource_type"`
+	Scope                         fwtypes.ListNestedObjectValueOf[scopeModel]                     `tfsdk:"scope"`
+	UtilizationPreferences        fwtypes.ListNestedObjectValueOf[utilizationPreferenceModel]     `tfsdk:"utilization_preference"`
+}
+
+const (
+	recommendationPreferencesResourceIDPartCount = 3
+)
+
+func (data *recommendationPreferencesResourceModel) setID(ctx context.Context) {
+	scope, _ := data.Scope.ToPtr(ctx)
+
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ResourceType.ValueString(), scope.Name.ValueString(), scope.Value.ValueString()}, recommendationPreferencesResourceIDPartCount, false)))
+}
+
+type externalMetricsPreferenceModel struct {
+	Source fwtypes.StringEnum[awstypes.ExternalMetricsSource] `tfsdk:"source"`
+}
+
+type scopeModel struct {
+	Name  fwtypes.StringEnum[awstypes.ScopeName] `tfsdk:"name"`
+	Value types.String                           `tfsdk:"value"`
+}
+
+type utilizationPreferenceModel struct {
+	MetricName       fwtypes.StringEnum[awstypes.CustomizableMetricName]                `tfsdk:"metric_name"`
+	MetricParameters fwtypes.ListNestedObjectValueOf[customizableMetricParametersModel] `tfsdk:"metric_parameters"`
+}
+
+type customizableMetricParametersModel struct {
+	Headroom  fwtypes.StringEnum[awstypes.CustomizableMetricHeadroom]  `tfsdk:"headroom"`
+	Threshold fwtypes.StringEnum[awstypes.CustomizableMetricThreshold] `tfsdk:"threshold"`
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("Active", "Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", "Inactive"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "Ec2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", "AccountId"),
+					resource.TestCheckResourceAttrPair(resourceName, "scope.0.value", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("Inactive", "Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Inactive"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("Active", "Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcomputeoptimizer.ResourceRecommendationPreferences, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_externalMetricsPreference(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_externalMetricsPreference("Datadog"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", "Datadog"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_externalMetricsPreference("Dynatrace"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", "Dynatrace"),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("Active", "Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_utilizationPreferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_utilizationPreferences,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_name", "CpuUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.headroom", "PERCENT_20"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.threshold", "P95"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_name", "MemoryUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_parameters.0.headroom", "PERCENT_30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_recommendation_preferences" {
+				continue
+			}
+
+			_, err := tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, rs.Primary.Attributes["resource_type"], awstypes.ScopeName(rs.Primary.Attributes["scope.0.name"]), rs.Primary.Attributes["scope.0.value"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Compute Optimizer Recommendation Preferences %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecommendationPreferencesExists(ctx context.Context, n string, v *awstypes.RecommendationPreferencesDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		output, err := tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, rs.Primary.Attributes["resource_type"], awstypes.ScopeName(rs.Primary.Attributes["scope.0.name"]), rs.Primary.Attributes["scope.0.value"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecommendationPreferencesConfig_basic(enhancedInfrastructureMetrics, inferredWorkloadTypes string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  enhanced_infrastructure_metrics = %[1]q
+  inferred_workload_types         = %[2]q
+}
+`, enhancedInfrastructureMetrics, inferredWorkloadTypes)
+}
+
+func testAccRecommendationPreferencesConfig_externalMetricsPreference(source string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  external_metrics_preference {
+    source = %[1]q
+  }
+}
+`, source)
+}
+
+const testAccRecommendationPreferencesConfig_utilizationPreferences = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  enhanced_infrastructure_metrics = "Active"
+
+  utilization_preference {
+    metric_name = "CpuUtilization"
+
+    metric_parameters {
+      headroom  = "PERCENT_20"
+      threshold = "P95"
+    }
+  }
+
+  utilization_preference {
+    metric_name = "MemoryUtilization"
+
+    metric_parameters {
+      headroom = "PERCENT_30"
+    }
+  }
+}
+`
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_controltower_landing_zone_drift", name="Landing Zone Drift")
+func dataSourceLandingZoneDrift() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLandingZoneDriftRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"landing_zone_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"latest_available_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLandingZoneDriftRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	id := d.Get("landing_zone_identifier").(string)
+
+	// There is at most one landing zone per account.
+	if id == "" {
+		landingZone, err := findLandingZone(ctx, conn, &controltower.ListLandingZonesInput{})
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ControlTower Landing Zone", err))
+		}
+
+		id = aws.ToString(landingZone.Arn)
+	}
+
+	landingZone, err := findLandingZoneByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ControlTower Landing Zone (%s): %s", id, err)
+	}
+
+	d.SetId(aws.ToString(landingZone.Arn))
+	d.Set("arn", landingZone.Arn)
+	if landingZone.DriftStatus != nil {
+		d.Set("drift_status", landingZone.DriftStatus.Status)
+	} else {
+		d.Set("drift_status", nil)
+	}
+	d.Set("landing_zone_identifier", id)
+	d.Set("latest_available_version", landingZone.LatestAvailableVersion)
+	d.Set("status", landingZone.Status)
+	d.Set("version", landingZone.Version)
+
+	return diags
+}
+
+func findLandingZone(ctx context.Context, conn *controltower.Client, input *controltower.ListLandingZonesInput) (*types.LandingZoneSummary, error) {
+	output, err := findLandingZones(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findLandingZones(ctx context.Context, conn *controltower.Client, input *controltower.ListLandingZonesInput) ([]*types.LandingZoneSummary, error) {
+	var output []*types.LandingZoneSummary
+
+	pages := controltower.NewListLandingZonesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LandingZones {
+			v := v
+			output = append(output, &v)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcontroltower "github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccControlTowerLandingZone_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"LandingZone": {
+			"basic":      testAccLandingZone_basic,
+			"disappears": testAccLandingZone_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccLandingZone_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var landingZone types.LandingZoneDetail
+	resourceName := "aws_controltower_landing_zone.test"
+	loggingAccountID := acctest.SkipIfEnvVarNotSet(t, "CONTROLTOWER_LOGGING_ACCOUNT_ID")
+	securityAccountID := acctest.SkipIfEnvVarNotSet(t, "CONTROLTOWER_SECURITY_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheckNoLandingZone(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerEndpointID),
+		CheckDestroy:             testAccCheckLandingZoneDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLandingZoneConfig_basic(loggingAccountID, securityAccountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(ctx, resourceName, &landingZone),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "drift_status.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_available_version"),
+					resource.TestCheckResourceAttr(resourceName, "version", "3.3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLandingZone_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var landingZone types.LandingZoneDetail
+	resourceName := "aws_controltower_landing_zone.test"
+	loggingAccountID := acctest.SkipIfEnvVarNotSet(t, "CONTROLTOWER_LOGGING_ACCOUNT_ID")
+	securityAccountID := acctest.SkipIfEnvVarNotSet(t, "CONTROLTOWER_SECURITY_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheckNoLandingZone(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerEndpointID),
+		CheckDestroy:             testAccCheckLandingZoneDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLandingZoneConfig_basic(loggingAccountID, securityAccountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(ctx, resourceName, &landingZone),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcontroltower.ResourceLandingZone(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckNoLandingZone(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
+
+	output, err := conn.ListLandingZones(ctx, &controltower.ListLandingZonesInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if len(output.LandingZones) > 0 {
+		t.Skip("skipping since a ControlTower Landing Zone already exists")
+	}
+}
+
+func testAccCheckLandingZoneExists(ctx context.Context, n string, v *types.LandingZoneDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
+
+		output, err := tfcontroltower.FindLandingZoneByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLandingZoneDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_controltower_landing_zone" {
+				continue
+			}
+
+			_, err := tfcontroltower.FindLandingZoneByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ControlTower Landing Zone %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLandingZoneConfig_basic(loggingAccountID, securityAccountID string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_controltower_landing_zone" "test" {
+  version = "3.3"
+
+  manifest_json = jsonencode({
+    governedRegions = [data.aws_region.current.name]
+    organizationStructure = {
+      security = {
+        name = "Security"
+      }
+    }
+    centralizedLogging = {
+      accountId = %[1]q
+      configurations = {
+        loggingBucket = {
+          retentionDays = 60
+        }
+        accessLoggingBucket = {
+          retentionDays = 60
+        }
+      }
+      enabled = true
+    }
+    securityRoles = {
+      accountId = %[2]q
+    }
+    accessManagement = {
+      enabled = true
+    }
+  })
+}
+`, loggingAccountID, securityAccountID)
+}
@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_controltower_controls",
 			Name:     "Control",
 		},
+		{
+			Factory:  dataSourceLandingZoneDrift,
+			TypeName: "aws_controltower_landing_zone_drift",
+			Name:     "Landing Zone Drift",
+		},
 	}
 }
 
@@ -39,6 +44,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_controltower_control",
 			Name:     "Control",
 		},
+		{
+			Factory:  resourceLandingZone,
+			TypeName: "aws_controltower_landing_zone",
+			Name:     "Landing Zone",
+		},
 	}
 }
 
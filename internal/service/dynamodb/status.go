@@ -222,3 +222,19 @@ func statusContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, tab
 		return insight, aws.StringValue(insight.ContributorInsightsStatus), nil
 	}
 }
+
+func statusTableExport(ctx context.Context, conn *dynamodb.DynamoDB, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTableExportByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ExportStatus), nil
+	}
+}
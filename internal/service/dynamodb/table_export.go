@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dynamodb_table_export", name="Table Export")
+func ResourceTableExport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableExportCreate,
+		ReadWithoutTimeout:   resourceTableExportRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billed_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.ExportFormatDynamodbJson,
+				ValidateFunc: validation.StringInSlice(dynamodb.ExportFormat_Values(), false),
+			},
+			"export_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidUTCTimestamp,
+				ConflictsWith: []string{"incremental_export_specification"},
+			},
+			"export_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.ExportTypeFullExport,
+				ValidateFunc: validation.StringInSlice(dynamodb.ExportType_Values(), false),
+			},
+			"incremental_export_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"export_from_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"export_to_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"export_view_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.ExportViewType_Values(), false),
+						},
+					},
+				},
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"manifest_files_s3_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"s3_sse_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.S3SseAlgorithm_Values(), false),
+			},
+			"s3_sse_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTableExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableARN := d.Get("table_arn").(string)
+	input := &dynamodb.ExportTableToPointInTimeInput{
+		ExportFormat: aws.String(d.Get("export_format").(string)),
+		ExportType:   aws.String(d.Get("export_type").(string)),
+		S3Bucket:     aws.String(d.Get("s3_bucket").(string)),
+		TableArn:     aws.String(tableARN),
+	}
+
+	if v, ok := d.GetOk("export_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExportTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("incremental_export_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalExportSpecification = expandIncrementalExportSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_bucket_owner"); ok {
+		input.S3BucketOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_algorithm"); ok {
+		input.S3SseAlgorithm = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_kms_key_id"); ok {
+		input.S3SseKmsKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.ExportTableToPointInTimeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Export: %s", tableARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.ExportDescription.ExportArn))
+
+	if _, err := waitTableExportCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Export (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTableExportRead(ctx, d, meta)...)
+}
+
+func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	export, err := findTableExportByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Export (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Export (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, export.ExportArn)
+	d.Set("billed_size_in_bytes", export.BilledSizeBytes)
+	d.Set("end_time", flattenTableExportTime(export.EndTime))
+	d.Set("export_format", export.ExportFormat)
+	d.Set("export_status", export.ExportStatus)
+	d.Set("export_time", flattenTableExportTime(export.ExportTime))
+	d.Set("export_type", export.ExportType)
+	if export.IncrementalExportSpecification != nil {
+		if err := d.Set("incremental_export_specification", []interface{}{flattenIncrementalExportSpecification(export.IncrementalExportSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting incremental_export_specification: %s", err)
+		}
+	} else {
+		d.Set("incremental_export_specification", nil)
+	}
+	d.Set("item_count", export.ItemCount)
+	d.Set("manifest_files_s3_key", export.ExportManifest)
+	d.Set("s3_bucket", export.S3Bucket)
+	d.Set("s3_bucket_owner", export.S3BucketOwner)
+	d.Set("s3_prefix", export.S3Prefix)
+	d.Set("s3_sse_algorithm", export.S3SseAlgorithm)
+	d.Set("s3_sse_kms_key_id", export.S3SseKmsKeyId)
+	d.Set("start_time", flattenTableExportTime(export.StartTime))
+	d.Set("table_arn", export.TableArn)
+
+	return diags
+}
+
+func expandIncrementalExportSpecification(tfMap map[string]interface{}) *dynamodb.IncrementalExportSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.IncrementalExportSpecification{}
+
+	if v, ok := tfMap["export_from_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportFromTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["export_to_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportToTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["export_view_type"].(string); ok && v != "" {
+		apiObject.ExportViewType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIncrementalExportSpecification(apiObject *dynamodb.IncrementalExportSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"export_from_time": flattenTableExportTime(apiObject.ExportFromTime),
+		"export_to_time":   flattenTableExportTime(apiObject.ExportToTime),
+		"export_view_type": aws.StringValue(apiObject.ExportViewType),
+	}
+}
+
+func flattenTableExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Tables")
+func newDataSourceTables(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTables{}, nil
+}
+
+const (
+	DSNameTables = "Tables Data Source"
+)
+
+type dataSourceTables struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTables) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_dynamodb_tables"
+}
+
+func (d *dataSourceTables) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tables": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tableSummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						"billing_mode": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTables) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().DynamoDBConn(ctx)
+
+	var data dataSourceTablesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableNames, err := findTableNames(ctx, conn, &dynamodb.ListTablesInput{})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DynamoDB, create.ErrActionReading, DSNameTables, d.Meta().Region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var summaries []*tableSummaryData
+
+	for _, name := range tableNames {
+		table, err := FindTableByName(ctx, conn, name)
+
+		// The table may have been deleted since it was listed.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DynamoDB, create.ErrActionReading, DSNameTables, name, err),
+				err.Error(),
+			)
+			return
+		}
+
+		// Tables created with provisioned capacity may not report a billing mode summary.
+		billingMode := dynamodb.BillingModeProvisioned
+		if v := table.BillingModeSummary; v != nil && v.BillingMode != nil {
+			billingMode = aws.StringValue(v.BillingMode)
+		}
+
+		summaries = append(summaries, &tableSummaryData{
+			ARN:         flex.StringToFramework(ctx, table.TableArn),
+			BillingMode: types.StringValue(billingMode),
+			Name:        flex.StringToFramework(ctx, table.TableName),
+			Status:      flex.StringToFramework(ctx, table.TableStatus),
+		})
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+	data.Names = flex.FlattenFrameworkStringValueList(ctx, tableNames)
+	data.Tables = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findTableNames(ctx context.Context, conn *dynamodb.DynamoDB, input *dynamodb.ListTablesInput) ([]string, error) {
+	var output []string
+
+	err := conn.ListTablesPagesWithContext(ctx, input, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TableNames {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type dataSourceTablesData struct {
+	ID     types.String                                      `tfsdk:"id"`
+	Names  types.List                                        `tfsdk:"names"`
+	Tables fwtypes.ListNestedObjectValueOf[tableSummaryData] `tfsdk:"tables"`
+}
+
+type tableSummaryData struct {
+	ARN         types.String `tfsdk:"arn"`
+	BillingMode types.String `tfsdk:"billing_mode"`
+	Name        types.String `tfsdk:"name"`
+	Status      types.String `tfsdk:"status"`
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -301,3 +303,25 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 
 	return err
 }
+
+func waitTableExportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusTableExport(ctx, conn, arn),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if code, message := aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage); code != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", code, message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Capacity Block Reservation")
+// @Tags(identifierAttribute="id")
+func newResourceCapacityBlockReservation(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCapacityBlockReservation{}
+	r.SetDefaultCreateTimeout(40 * time.Minute)
+
+	return r, nil
+}
+
+type resourceCapacityBlockReservation struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCapacityBlockReservation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_capacity_block_reservation"
+}
+
+func (r *resourceCapacityBlockReservation) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"availability_zone": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"capacity_block_offering_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ebs_optimized": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_date_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EndDateType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_count": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_platform": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationInstancePlatform](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"outpost_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"placement_group_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reservation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// The reservation moves from scheduled to active to expired over its lifetime.
+			"state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationState](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tenancy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationTenancy](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceCapacityBlockReservation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCapacityBlockReservationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.PurchaseCapacityBlockInput{
+		TagSpecifications: getTagSpecificationsInV2(ctx, awstypes.ResourceTypeCapacityReservation),
+	}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PurchaseCapacityBlock(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("purchasing EC2 Capacity Block (%s)", data.CapacityBlockOfferingId.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CapacityReservationId = types.StringPointerValue(output.CapacityReservation.CapacityReservationId)
+	id := data.CapacityReservationId.ValueString()
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	reservation, err := waitCapacityBlockReservationActive(ctx, conn, id, createTimeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.CapacityReservationId) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Capacity Block Reservation (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(r.flatten(ctx, reservation, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCapacityBlockReservation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCapacityBlockReservationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.CapacityReservationId.ValueString()
+	reservation, err := findCapacityBlockReservationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Capacity Block Reservation (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, reservation, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOutV2(ctx, reservation.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCapacityBlockReservation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceCapacityBlockReservationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Refresh the computed attributes, e.g. state, which may have moved on since the last read.
+	id := new.CapacityReservationId.ValueString()
+	reservation, err := findCapacityBlockReservationByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Capacity Block Reservation (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, reservation, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceCapacityBlockReservation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceCapacityBlockReservationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Capacity Blocks are paid for up front and cannot be cancelled.
+	// Removing the resource only removes it from Terraform state.
+	response.Diagnostics.AddWarning(
+		"EC2 Capacity Block Reservation cannot be cancelled",
+		fmt.Sprintf("EC2 Capacity Block Reservation (%s) has been removed from Terraform state but remains in place until its end date (%s).", data.CapacityReservationId.ValueString(), data.EndDate.ValueString()),
+	)
+}
+
+func (r *resourceCapacityBlockReservation) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *resourceCapacityBlockReservation) flatten(ctx context.Context, reservation *awstypes.CapacityReservation, data *resourceCapacityBlockReservationData) diag.Diagnostics {
+	diags := flex.Flatten(ctx, reservation, data)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	data.InstanceCount = flex.Int32ToFramework(ctx, reservation.TotalInstanceCount)
+
+	return diags
+}
+
+// findCapacityBlockReservationByID returns the Capacity Block reservation with the specified ID.
+// Expired reservations are still returned so that the lifecycle is visible; reservations that
+// were never paid for are treated as not found.
+func findCapacityBlockReservationByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.CapacityReservation, error) {
+	output, err := findCapacityReservationByIDV2(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ReservationType != awstypes.CapacityReservationTypeCapacityBlock {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("EC2 Capacity Reservation (%s) is not a Capacity Block", id),
+		}
+	}
+
+	switch state := output.State; state {
+	case awstypes.CapacityReservationStateCancelled, awstypes.CapacityReservationStateFailed, awstypes.CapacityReservationStatePaymentFailed:
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CapacityReservation.html.
+type resourceCapacityBlockReservationData struct {
+	AvailabilityZone        types.String                                                     `tfsdk:"availability_zone"`
+	CapacityBlockOfferingId types.String                                                     `tfsdk:"capacity_block_offering_id"`
+	CapacityReservationArn  types.String                                                     `tfsdk:"arn"`
+	CapacityReservationId   types.String                                                     `tfsdk:"id"`
+	CreateDate              fwtypes.Timestamp                                                `tfsdk:"created_date"`
+	EbsOptimized            types.Bool                                                       `tfsdk:"ebs_optimized"`
+	EndDate                 fwtypes.Timestamp                                                `tfsdk:"end_date"`
+	EndDateType             fwtypes.StringEnum[awstypes.EndDateType]                         `tfsdk:"end_date_type"`
+	InstanceCount           types.Int64                                                      `tfsdk:"instance_count"`
+	InstancePlatform        fwtypes.StringEnum[awstypes.CapacityReservationInstancePlatform] `tfsdk:"instance_platform"`
+	InstanceType            types.String                                                     `tfsdk:"instance_type"`
+	OutpostArn              types.String                                                     `tfsdk:"outpost_arn"`
+	PlacementGroupArn       types.String                                                     `tfsdk:"placement_group_arn"`
+	ReservationType         fwtypes.StringEnum[awstypes.CapacityReservationType]             `tfsdk:"reservation_type"`
+	StartDate               fwtypes.Timestamp                                                `tfsdk:"start_date"`
+	State                   fwtypes.StringEnum[awstypes.CapacityReservationState]            `tfsdk:"state"`
+	Tags                    types.Map                                                        `tfsdk:"tags"`
+	TagsAll                 types.Map                                                        `tfsdk:"tags_all"`
+	Tenancy                 fwtypes.StringEnum[awstypes.CapacityReservationTenancy]          `tfsdk:"tenancy"`
+	Timeouts                timeouts.Value                                                   `tfsdk:"timeouts"`
+}
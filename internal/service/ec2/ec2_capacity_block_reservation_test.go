@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+// Capacity Blocks are charged up front and cannot be cancelled, so this test only runs when explicitly requested.
+func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	acctest.SkipIfEnvVarNotSet(t, "TF_AWS_RUN_CAPACITY_BLOCK_RESERVATION_TEST")
+
+	resourceName := "aws_ec2_capacity_block_reservation.test"
+	dataSourceName := "data.aws_ec2_capacity_block_offering.test"
+	startDate := time.Now().UTC().Add(25 * time.Hour).Format(time.RFC3339)
+	endDate := time.Now().UTC().Add(720 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockReservationConfig_basic(startDate, endDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityBlockReservationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexache.MustCompile(`capacity-reservation/cr-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", dataSourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair(resourceName, "capacity_block_offering_id", dataSourceName, "capacity_block_offering_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "end_date", dataSourceName, "end_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_count", dataSourceName, "instance_count"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", dataSourceName, "instance_type"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_type", "capacity-block"),
+					resource.TestCheckResourceAttrPair(resourceName, "start_date", dataSourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "state", "scheduled"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test"),
+				),
+			},
+			{
+				Config: testAccCapacityBlockReservationConfig_tags2(startDate, endDate, "Name", "test-updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityBlockReservationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "scheduled"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test-updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityBlockReservationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Block Reservation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindCapacityBlockReservationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCapacityBlockReservationConfig_basic(startDate, endDate string) string {
+	return acctest.ConfigCompose(testAccCapacityBlockOfferingDataSourceConfig_basic(startDate, endDate), `
+resource "aws_ec2_capacity_block_reservation" "test" {
+  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.capacity_block_offering_id
+  instance_platform          = "Linux/UNIX"
+
+  tags = {
+    "Name" = "test"
+  }
+}
+`)
+}
+
+func testAccCapacityBlockReservationConfig_tags2(startDate, endDate, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCapacityBlockOfferingDataSourceConfig_basic(startDate, endDate), fmt.Sprintf(`
+resource "aws_ec2_capacity_block_reservation" "test" {
+  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.capacity_block_offering_id
+  instance_platform          = "Linux/UNIX"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// A hop limit of -1 indicates no preference.
+	instanceMetadataDefaultsHopLimitNoPreference = -1
+)
+
+// @FrameworkResource(name="Instance Metadata Defaults")
+func newResourceInstanceMetadataDefaults(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceInstanceMetadataDefaults{}
+
+	return r, nil
+}
+
+type resourceInstanceMetadataDefaults struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceInstanceMetadataDefaults) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_instance_metadata_defaults"
+}
+
+func (r *resourceInstanceMetadataDefaults) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	httpEndpointType := fwtypes.StringEnumType[awstypes.DefaultInstanceMetadataEndpointState]()
+	httpTokensType := fwtypes.StringEnumType[awstypes.MetadataDefaultHttpTokensState]()
+	instanceMetadataTagsType := fwtypes.StringEnumType[awstypes.DefaultInstanceMetadataTagsState]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"http_endpoint": schema.StringAttribute{
+				CustomType: httpEndpointType,
+				Optional:   true,
+				Computed:   true,
+				Default:    httpEndpointType.AttributeDefault(awstypes.DefaultInstanceMetadataEndpointStateNoPreference),
+			},
+			"http_put_response_hop_limit": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(instanceMetadataDefaultsHopLimitNoPreference),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(instanceMetadataDefaultsHopLimitNoPreference),
+						int64validator.Between(1, 64),
+					),
+				},
+			},
+			"http_tokens": schema.StringAttribute{
+				CustomType: httpTokensType,
+				Optional:   true,
+				Computed:   true,
+				Default:    httpTokensType.AttributeDefault(awstypes.MetadataDefaultHttpTokensStateNoPreference),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_metadata_tags": schema.StringAttribute{
+				CustomType: instanceMetadataTagsType,
+				Optional:   true,
+				Computed:   true,
+				Default:    instanceMetadataTagsType.AttributeDefault(awstypes.DefaultInstanceMetadataTagsStateNoPreference),
+			},
+		},
+	}
+}
+
+func (r *resourceInstanceMetadataDefaults) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceInstanceMetadataDefaultsData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if err := modifyInstanceMetadataDefaults(ctx, conn, data.expand()); err != nil {
+		response.Diagnostics.AddError("creating EC2 Instance Metadata Defaults", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(r.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceInstanceMetadataDefaults) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceInstanceMetadataDefaultsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findInstanceMetadataDefaults(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Instance Metadata Defaults (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceInstanceMetadataDefaults) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceInstanceMetadataDefaultsData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if err := modifyInstanceMetadataDefaults(ctx, conn, new.expand()); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Instance Metadata Defaults (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceInstanceMetadataDefaults) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceInstanceMetadataDefaultsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Reset all settings to "no-preference".
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            awstypes.DefaultInstanceMetadataEndpointStateNoPreference,
+		HttpPutResponseHopLimit: aws.Int32(instanceMetadataDefaultsHopLimitNoPreference),
+		HttpTokens:              awstypes.MetadataDefaultHttpTokensStateNoPreference,
+		InstanceMetadataTags:    awstypes.DefaultInstanceMetadataTagsStateNoPreference,
+	}
+
+	if err := modifyInstanceMetadataDefaults(ctx, conn, input); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Instance Metadata Defaults (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func modifyInstanceMetadataDefaults(ctx context.Context, conn *ec2.Client, input *ec2.ModifyInstanceMetadataDefaultsInput) error {
+	output, err := conn.ModifyInstanceMetadataDefaults(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if !aws.ToBool(output.Return) {
+		return fmt.Errorf("ModifyInstanceMetadataDefaults returned %t", aws.ToBool(output.Return))
+	}
+
+	return nil
+}
+
+func findInstanceMetadataDefaults(ctx context.Context, conn *ec2.Client) (*awstypes.InstanceMetadataDefaultsResponse, error) {
+	input := &ec2.GetInstanceMetadataDefaultsInput{}
+
+	output, err := conn.GetInstanceMetadataDefaults(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// No account-level defaults have been set.
+	if output.AccountLevel == nil {
+		return &awstypes.InstanceMetadataDefaultsResponse{}, nil
+	}
+
+	return output.AccountLevel, nil
+}
+
+type resourceInstanceMetadataDefaultsData struct {
+	HttpEndpoint            fwtypes.StringEnum[awstypes.DefaultInstanceMetadataEndpointState] `tfsdk:"http_endpoint"`
+	HttpPutResponseHopLimit types.Int64                                                       `tfsdk:"http_put_response_hop_limit"`
+	HttpTokens              fwtypes.StringEnum[awstypes.MetadataDefaultHttpTokensState]       `tfsdk:"http_tokens"`
+	ID                      types.String                                                      `tfsdk:"id"`
+	InstanceMetadataTags    fwtypes.StringEnum[awstypes.DefaultInstanceMetadataTagsState]     `tfsdk:"instance_metadata_tags"`
+}
+
+func (data *resourceInstanceMetadataDefaultsData) expand() *ec2.ModifyInstanceMetadataDefaultsInput {
+	return &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            data.HttpEndpoint.ValueEnum(),
+		HttpPutResponseHopLimit: aws.Int32(int32(data.HttpPutResponseHopLimit.ValueInt64())),
+		HttpTokens:              data.HttpTokens.ValueEnum(),
+		InstanceMetadataTags:    data.InstanceMetadataTags.ValueEnum(),
+	}
+}
+
+// flatten maps unset account-level defaults to their "no-preference" values.
+func (data *resourceInstanceMetadataDefaultsData) flatten(apiObject *awstypes.InstanceMetadataDefaultsResponse) {
+	data.HttpEndpoint = fwtypes.StringEnumValue(awstypes.DefaultInstanceMetadataEndpointStateNoPreference)
+	if v := apiObject.HttpEndpoint; v != "" {
+		data.HttpEndpoint = fwtypes.StringEnumValue(awstypes.DefaultInstanceMetadataEndpointState(v))
+	}
+
+	data.HttpPutResponseHopLimit = types.Int64Value(instanceMetadataDefaultsHopLimitNoPreference)
+	if v := apiObject.HttpPutResponseHopLimit; v != nil {
+		data.HttpPutResponseHopLimit = types.Int64Value(int64(aws.ToInt32(v)))
+	}
+
+	data.HttpTokens = fwtypes.StringEnumValue(awstypes.MetadataDefaultHttpTokensStateNoPreference)
+	if v := apiObject.HttpTokens; v != "" {
+		data.HttpTokens = fwtypes.StringEnumValue(awstypes.MetadataDefaultHttpTokensState(v))
+	}
+
+	data.InstanceMetadataTags = fwtypes.StringEnumValue(awstypes.DefaultInstanceMetadataTagsStateNoPreference)
+	if v := apiObject.InstanceMetadataTags; v != "" {
+		data.InstanceMetadataTags = fwtypes.StringEnumValue(awstypes.DefaultInstanceMetadataTagsState(v))
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Instance Metadata Defaults")
+func newDataSourceInstanceMetadataDefaults(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceInstanceMetadataDefaults{}, nil
+}
+
+type dataSourceInstanceMetadataDefaults struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceInstanceMetadataDefaults) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ec2_instance_metadata_defaults"
+}
+
+func (d *dataSourceInstanceMetadataDefaults) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"http_endpoint": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InstanceMetadataEndpointState](),
+				Computed:   true,
+			},
+			"http_put_response_hop_limit": schema.Int64Attribute{
+				Computed: true,
+			},
+			"http_tokens": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.HttpTokensState](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_metadata_tags": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InstanceMetadataTagsState](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceInstanceMetadataDefaults) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceInstanceMetadataDefaultsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	output, err := findInstanceMetadataDefaults(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Instance Metadata Defaults", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_InstanceMetadataDefaultsResponse.html.
+type dataSourceInstanceMetadataDefaultsData struct {
+	HttpEndpoint            fwtypes.StringEnum[awstypes.InstanceMetadataEndpointState] `tfsdk:"http_endpoint"`
+	HttpPutResponseHopLimit types.Int64                                                `tfsdk:"http_put_response_hop_limit"`
+	HttpTokens              fwtypes.StringEnum[awstypes.HttpTokensState]               `tfsdk:"http_tokens"`
+	ID                      types.String                                               `tfsdk:"id"`
+	InstanceMetadataTags    fwtypes.StringEnum[awstypes.InstanceMetadataTagsState]     `tfsdk:"instance_metadata_tags"`
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+// Instance metadata defaults are a per-Region account setting, so these tests must not run in parallel.
+func TestAccEC2InstanceMetadataDefaults_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccInstanceMetadataDefaults_basic,
+		"disappears": testAccInstanceMetadataDefaults_disappears,
+		"dataSource": testAccInstanceMetadataDefaultsDataSource_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccInstanceMetadataDefaults_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_full,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_partial,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "no-preference"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceMetadataDefaults_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_full,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceInstanceMetadataDefaults, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceMetadataDefaultsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_instance_metadata_defaults" {
+				continue
+			}
+
+			output, err := tfec2.FindInstanceMetadataDefaults(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if output.HttpEndpoint != "" || output.HttpPutResponseHopLimit != nil || output.HttpTokens != "" || output.InstanceMetadataTags != "" {
+				return fmt.Errorf("EC2 Instance Metadata Defaults %s still set", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInstanceMetadataDefaultsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindInstanceMetadataDefaults(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := string(output.HttpTokens), rs.Primary.Attributes["http_tokens"]; got != want && !(got == "" && want == "no-preference") {
+			return fmt.Errorf("EC2 Instance Metadata Defaults http_tokens = %s, want %s", got, want)
+		}
+
+		if v := output.HttpPutResponseHopLimit; v != nil && fmt.Sprint(aws.ToInt32(v)) != rs.Primary.Attributes["http_put_response_hop_limit"] {
+			return fmt.Errorf("EC2 Instance Metadata Defaults http_put_response_hop_limit = %d, want %s", aws.ToInt32(v), rs.Primary.Attributes["http_put_response_hop_limit"])
+		}
+
+		return nil
+	}
+}
+
+const testAccInstanceMetadataDefaultsConfig_full = `
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_endpoint               = "enabled"
+  http_put_response_hop_limit = 1
+  http_tokens                 = "required"
+  instance_metadata_tags      = "disabled"
+}
+`
+
+const testAccInstanceMetadataDefaultsConfig_partial = `
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_tokens                 = "required"
+  http_put_response_hop_limit = 2
+}
+`
@@ -5,12 +5,18 @@ package ec2
 
 // Exports for use in tests only.
 var (
-	ResourceInstanceConnectEndpoint  = newResourceInstanceConnectEndpoint
-	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
-	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
-	ResourceEBSFastSnapshotRestore   = newResourceEBSFastSnapshotRestore
+	ResourceCapacityBlockReservation                   = newResourceCapacityBlockReservation
+	ResourceInstanceConnectEndpoint                    = newResourceInstanceConnectEndpoint
+	ResourceInstanceMetadataDefaults                   = newResourceInstanceMetadataDefaults
+	ResourceSecurityGroupEgressRule                    = newResourceSecurityGroupEgressRule
+	ResourceSecurityGroupIngressRule                   = newResourceSecurityGroupIngressRule
+	ResourceTransitGatewayDefaultRouteTableAssociation = newResourceTransitGatewayDefaultRouteTableAssociation
+	ResourceTransitGatewayDefaultRouteTablePropagation = newResourceTransitGatewayDefaultRouteTablePropagation
+	ResourceEBSFastSnapshotRestore                     = newResourceEBSFastSnapshotRestore
 
-	FindEBSFastSnapshotRestoreByID = findEBSFastSnapshotRestoreByID
+	FindCapacityBlockReservationByID = findCapacityBlockReservationByID
+	FindEBSFastSnapshotRestoreByID   = findEBSFastSnapshotRestoreByID
+	FindInstanceMetadataDefaults     = findInstanceMetadataDefaults
 
 	UpdateTags   = updateTags
 	UpdateTagsV2 = updateTagsV2
@@ -19,6 +19,14 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceCapacityBlockOffering,
+			Name:    "Capacity Block Offering",
+		},
+		{
+			Factory: newDataSourceInstanceMetadataDefaults,
+			Name:    "Instance Metadata Defaults",
+		},
 		{
 			Factory: newDataSourceSecurityGroupRule,
 		},
@@ -30,6 +38,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceCapacityBlockReservation,
+			Name:    "Capacity Block Reservation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory: newResourceEBSFastSnapshotRestore,
 			Name:    "EBS Fast Snapshot Restore",
@@ -41,6 +56,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory: newResourceInstanceMetadataDefaults,
+			Name:    "Instance Metadata Defaults",
+		},
 		{
 			Factory: newResourceSecurityGroupEgressRule,
 			Name:    "Security Group Egress Rule",
@@ -55,6 +74,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory: newResourceTransitGatewayDefaultRouteTableAssociation,
+			Name:    "Transit Gateway Default Route Table Association",
+		},
+		{
+			Factory: newResourceTransitGatewayDefaultRouteTablePropagation,
+			Name:    "Transit Gateway Default Route Table Propagation",
+		},
 	}
 }
 
@@ -60,3 +60,19 @@ func statusVPCAttributeValueV2(ctx context.Context, conn *ec2.Client, id string,
 		return attributeValue, strconv.FormatBool(attributeValue), nil
 	}
 }
+
+func statusCapacityReservationStateV2(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCapacityReservationByIDV2(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
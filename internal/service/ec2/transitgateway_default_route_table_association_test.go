@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTransitGatewayDefaultRouteTableAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitgateway ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway_default_route_table_association.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, transitGatewayRouteTableResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitgateway),
+					testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "original_default_route_table_id", transitGatewayResourceName, "association_default_route_table_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayDefaultRouteTableAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitgateway ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway_default_route_table_association.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, "aws_ec2_transit_gateway_route_table.test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitgateway),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGatewayDefaultRouteTableAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayDefaultRouteTableAssociation_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitgateway ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway_default_route_table_association.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, "aws_ec2_transit_gateway_route_table.test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitgateway),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", "aws_ec2_transit_gateway_route_table.test", "id"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, "aws_ec2_transit_gateway_route_table.test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitgateway),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", "aws_ec2_transit_gateway_route_table.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_default_route_table_association" {
+				continue
+			}
+
+			tgw, err := tfec2.FindTransitGatewayByID(ctx, conn, rs.Primary.Attributes["transit_gateway_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if got, want := aws.StringValue(tgw.Options.AssociationDefaultRouteTableId), rs.Primary.Attributes["original_default_route_table_id"]; got != want {
+				return fmt.Errorf("EC2 Transit Gateway (%s) default association route table not restored: %s, want %s", rs.Primary.ID, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		tgw, err := tfec2.FindTransitGatewayByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.StringValue(tgw.Options.AssociationDefaultRouteTableId), rs.Primary.Attributes["transit_gateway_route_table_id"]; got != want {
+			return fmt.Errorf("EC2 Transit Gateway (%s) default association route table: %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, routeTableResourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test2" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_default_route_table_association" "test" {
+  transit_gateway_id             = aws_ec2_transit_gateway.test.id
+  transit_gateway_route_table_id = %[2]s.id
+}
+`, rName, routeTableResourceName)
+}
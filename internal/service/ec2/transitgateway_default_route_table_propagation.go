@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Transit Gateway Default Route Table Propagation")
+func newResourceTransitGatewayDefaultRouteTablePropagation(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTransitGatewayDefaultRouteTablePropagation{}
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type resourceTransitGatewayDefaultRouteTablePropagation struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceTransitGatewayDefaultRouteTablePropagation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_transit_gateway_default_route_table_propagation"
+}
+
+func (r *resourceTransitGatewayDefaultRouteTablePropagation) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"original_default_route_table_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transit_gateway_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"transit_gateway_route_table_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceTransitGatewayDefaultRouteTablePropagation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTransitGatewayDefaultRouteTablePropagationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	tgwID := data.TransitGatewayID.ValueString()
+	tgw, err := FindTransitGatewayByID(ctx, conn, tgwID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Transit Gateway (%s)", tgwID), err.Error())
+
+		return
+	}
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &ec2.ModifyTransitGatewayOptions{
+			PropagationDefaultRouteTableId: flex.StringFromFramework(ctx, data.RouteTableID),
+		},
+		TransitGatewayId: aws.String(tgwID),
+	}
+
+	if err := modifyTransitGatewayOptions(ctx, conn, input, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Transit Gateway Default Route Table Propagation (%s)", tgwID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(tgwID)
+	data.OriginalRouteTableID = flex.StringToFramework(ctx, tgw.Options.PropagationDefaultRouteTableId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTransitGatewayDefaultRouteTablePropagation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTransitGatewayDefaultRouteTablePropagationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	tgw, err := FindTransitGatewayByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Transit Gateway Default Route Table Propagation (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.RouteTableID = flex.StringToFramework(ctx, tgw.Options.PropagationDefaultRouteTableId)
+	data.TransitGatewayID = flex.StringToFramework(ctx, tgw.TransitGatewayId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTransitGatewayDefaultRouteTablePropagation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceTransitGatewayDefaultRouteTablePropagationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	if !new.RouteTableID.Equal(old.RouteTableID) {
+		input := &ec2.ModifyTransitGatewayInput{
+			Options: &ec2.ModifyTransitGatewayOptions{
+				PropagationDefaultRouteTableId: flex.StringFromFramework(ctx, new.RouteTableID),
+			},
+			TransitGatewayId: flex.StringFromFramework(ctx, new.TransitGatewayID),
+		}
+
+		if err := modifyTransitGatewayOptions(ctx, conn, input, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Transit Gateway Default Route Table Propagation (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTransitGatewayDefaultRouteTablePropagation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTransitGatewayDefaultRouteTablePropagationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to restore if the Transit Gateway had no default propagation route table.
+	if data.OriginalRouteTableID.IsNull() || data.OriginalRouteTableID.ValueString() == "" {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &ec2.ModifyTransitGatewayOptions{
+			PropagationDefaultRouteTableId: flex.StringFromFramework(ctx, data.OriginalRouteTableID),
+		},
+		TransitGatewayId: flex.StringFromFramework(ctx, data.TransitGatewayID),
+	}
+
+	err := modifyTransitGatewayOptions(ctx, conn, input, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Transit Gateway Default Route Table Propagation (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceTransitGatewayDefaultRouteTablePropagationData struct {
+	ID                   types.String   `tfsdk:"id"`
+	OriginalRouteTableID types.String   `tfsdk:"original_default_route_table_id"`
+	RouteTableID         types.String   `tfsdk:"transit_gateway_route_table_id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	TransitGatewayID     types.String   `tfsdk:"transit_gateway_id"`
+}
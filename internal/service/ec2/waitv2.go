@@ -97,3 +97,20 @@ func waitVPCIPv6CIDRBlockAssociationDeletedV2(ctx context.Context, conn *ec2.Cli
 
 	return nil, err
 }
+
+func waitCapacityBlockReservationActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*types.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CapacityReservationStatePaymentPending, types.CapacityReservationStatePending),
+		Target:  enum.Slice(types.CapacityReservationStateScheduled, types.CapacityReservationStateActive),
+		Refresh: statusCapacityReservationStateV2(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
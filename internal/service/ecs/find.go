@@ -157,3 +157,60 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+// findStoppedTaskReasonsByDeploymentID returns the distinct reasons that the most recently stopped tasks of the specified
+// service deployment were stopped.
+func findStoppedTaskReasonsByDeploymentID(ctx context.Context, conn *ecs.ECS, cluster, deploymentID string) ([]string, error) {
+	listInput := &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(100),
+		StartedBy:     aws.String(deploymentID),
+	}
+
+	listOutput, err := conn.ListTasksWithContext(ctx, listInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if listOutput == nil || len(listOutput.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   listOutput.TaskArns,
+	}
+
+	describeOutput, err := conn.DescribeTasksWithContext(ctx, describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, nil
+	}
+
+	var reasons []string
+	seen := make(map[string]bool)
+	add := func(reason string) {
+		if reason != "" && !seen[reason] {
+			seen[reason] = true
+			reasons = append(reasons, reason)
+		}
+	}
+
+	for _, task := range describeOutput.Tasks {
+		add(aws.StringValue(task.StoppedReason))
+
+		for _, container := range task.Containers {
+			if v := aws.StringValue(container.Reason); v != "" {
+				add(fmt.Sprintf("%s: %s", aws.StringValue(container.Name), v))
+			}
+		}
+	}
+
+	return reasons, nil
+}
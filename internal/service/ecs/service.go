@@ -164,6 +164,34 @@ func ResourceService() *schema.Resource {
 					return false
 				},
 			},
+			"deployments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rollout_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rollout_state_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"task_definition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"desired_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -742,6 +770,10 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting deployment_controller: %s", err)
 	}
 
+	if err := d.Set("deployments", flattenDeployments(service.Deployments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deployments: %s", err)
+	}
+
 	if service.LoadBalancers != nil {
 		if err := d.Set("load_balancer", flattenLoadBalancers(service.LoadBalancers)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting load_balancer: %s", err)
@@ -1166,6 +1198,30 @@ func flattenDeploymentController(deploymentController *ecs.DeploymentController)
 	return []interface{}{m}
 }
 
+func flattenDeployments(apiObjects []*ecs.Deployment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":                   aws.StringValue(apiObject.Id),
+			"rollout_state":        aws.StringValue(apiObject.RolloutState),
+			"rollout_state_reason": aws.StringValue(apiObject.RolloutStateReason),
+			"status":               aws.StringValue(apiObject.Status),
+			"task_definition":      aws.StringValue(apiObject.TaskDefinition),
+		})
+	}
+
+	return tfList
+}
+
 func expandDeploymentCircuitBreaker(tfMap map[string]interface{}) *ecs.DeploymentCircuitBreaker {
 	if tfMap == nil {
 		return nil
@@ -147,8 +147,9 @@ func TestAccECSService_basicImport(t *testing.T) {
 				ImportStateId:     importInput,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// wait_for_steady_state is not read from API.
+				// deployments may progress after apply.
+				ImportStateVerifyIgnore: []string{"deployments", "wait_for_steady_state"},
 			},
 			// Test non-existent resource import
 			{
@@ -651,6 +652,38 @@ func TestAccECSService_deploymentCircuitBreaker(t *testing.T) {
 	})
 }
 
+func TestAccECSService_DeploymentCircuitBreaker_rollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_deploymentCircuitBreakerWait(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "deployments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployments.0.rollout_state", "COMPLETED"),
+				),
+			},
+			{
+				Config:      testAccServiceConfig_deploymentCircuitBreakerWait(rName, "failing"),
+				ExpectError: regexache.MustCompile(`deployment \(ecs-svc/[0-9]+\) (failed|replaced)`),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/3444
 func TestAccECSService_loadBalancerChanges(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1045,6 +1078,11 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployments.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployments.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "deployments.0.rollout_state", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "deployments.0.status", "PRIMARY"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployments.0.task_definition", "aws_ecs_task_definition.test", "arn"),
 					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
 				),
 			},
@@ -1459,8 +1497,9 @@ func TestAccECSService_Tags_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_steady_state is not read from API.
+				// deployments may progress after apply.
+				ImportStateVerifyIgnore: []string{"deployments", "task_definition", "wait_for_steady_state"},
 			},
 			{
 				Config: testAccServiceConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -3929,6 +3968,53 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_deploymentCircuitBreakerWait(rName, taskDefinition string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "failing" {
+  family                   = "%[1]s-failing"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 256,
+    "essential": true,
+    "image": "public.ecr.aws/docker/library/busybox:latest",
+    "command": ["false"],
+    "memory": 512,
+    "name": "busybox",
+    "networkMode": "awsvpc"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.%[2]s.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = true
+  }
+
+  wait_for_steady_state = true
+}
+`, rName, taskDefinition))
+}
+
 func testAccServiceConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
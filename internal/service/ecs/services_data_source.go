@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Services")
+func newDataSourceServices(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceServices{}, nil
+}
+
+const (
+	DSNameServices = "Services Data Source"
+
+	// DescribeServices accepts at most 10 services per call.
+	describeServicesBatchSize = 10
+)
+
+type dataSourceServices struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceServices) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_ecs_services"
+}
+
+func (d *dataSourceServices) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"cluster_arn": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"launch_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ecs.LaunchType_Values()...),
+				},
+			},
+			"scheduling_strategy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ecs.SchedulingStrategy_Values()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"services": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[serviceSummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						"desired_count": schema.Int64Attribute{
+							Computed: true,
+						},
+						"launch_type": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"running_count": schema.Int64Attribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceServices) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ECSConn(ctx)
+
+	var data dataSourceServicesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster := data.ClusterARN.ValueString()
+	input := &ecs.ListServicesInput{
+		Cluster:            aws.String(cluster),
+		LaunchType:         flex.StringFromFramework(ctx, data.LaunchType),
+		SchedulingStrategy: flex.StringFromFramework(ctx, data.SchedulingStrategy),
+	}
+
+	arns, err := findServiceARNs(ctx, conn, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECS, create.ErrActionReading, DSNameServices, cluster, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var summaries []*serviceSummaryData
+
+	for _, chunk := range tfslices.Chunks(arns, describeServicesBatchSize) {
+		input := &ecs.DescribeServicesInput{
+			Cluster:  aws.String(cluster),
+			Services: aws.StringSlice(chunk),
+		}
+
+		output, err := conn.DescribeServicesWithContext(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.ECS, create.ErrActionReading, DSNameServices, cluster, err),
+				err.Error(),
+			)
+			return
+		}
+
+		for _, v := range output.Services {
+			if v == nil {
+				continue
+			}
+
+			summaries = append(summaries, &serviceSummaryData{
+				ARN:          flex.StringToFramework(ctx, v.ServiceArn),
+				DesiredCount: flex.Int64ToFramework(ctx, v.DesiredCount),
+				LaunchType:   flex.StringToFramework(ctx, v.LaunchType),
+				Name:         flex.StringToFramework(ctx, v.ServiceName),
+				RunningCount: flex.Int64ToFramework(ctx, v.RunningCount),
+				Status:       flex.StringToFramework(ctx, v.Status),
+			})
+		}
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.ID = types.StringValue(cluster)
+	data.Services = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findServiceARNs(ctx context.Context, conn *ecs.ECS, input *ecs.ListServicesInput) ([]string, error) {
+	var output []string
+
+	err := conn.ListServicesPagesWithContext(ctx, input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceArns {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type dataSourceServicesData struct {
+	ARNs               types.List                                          `tfsdk:"arns"`
+	ClusterARN         types.String                                        `tfsdk:"cluster_arn"`
+	ID                 types.String                                        `tfsdk:"id"`
+	LaunchType         types.String                                        `tfsdk:"launch_type"`
+	SchedulingStrategy types.String                                        `tfsdk:"scheduling_strategy"`
+	Services           fwtypes.ListNestedObjectValueOf[serviceSummaryData] `tfsdk:"services"`
+}
+
+type serviceSummaryData struct {
+	ARN          types.String `tfsdk:"arn"`
+	DesiredCount types.Int64  `tfsdk:"desired_count"`
+	LaunchType   types.String `tfsdk:"launch_type"`
+	Name         types.String `tfsdk:"name"`
+	RunningCount types.Int64  `tfsdk:"running_count"`
+	Status       types.String `tfsdk:"status"`
+}
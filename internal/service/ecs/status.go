@@ -17,9 +17,12 @@ const (
 	serviceStatusActive   = "ACTIVE"
 	serviceStatusDraining = "DRAINING"
 	// Non-standard statuses for statusServiceWaitForStable()
+	serviceStatusFailed  = "tfFAILED"
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	deploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
@@ -71,7 +74,10 @@ func statusServiceNoTags(ctx context.Context, conn *ecs.ECS, id, cluster string)
 	}
 }
 
-func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string) retry.StateRefreshFunc {
+// statusServiceWaitForStable follows the service's primary deployment.
+// The ID of the primary deployment first seen is stored in deploymentID. The status is serviceStatusFailed
+// if that deployment's rollout fails or it is replaced as the primary deployment, e.g. by a rollback.
+func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string, deploymentID *string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(ctx, conn, id, cluster)()
 		if err != nil {
@@ -83,6 +89,28 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 		}
 
 		service := serviceRaw.(*ecs.Service)
+		primary := primaryDeployment(service)
+
+		if *deploymentID == "" && primary != nil {
+			*deploymentID = aws.StringValue(primary.Id)
+		}
+
+		if *deploymentID != "" {
+			if deployment := findDeployment(service, *deploymentID); deployment == nil || aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+				return service, serviceStatusFailed, nil
+			}
+
+			if primary == nil || aws.StringValue(primary.Id) != *deploymentID {
+				return service, serviceStatusFailed, nil
+			}
+		}
+
+		// Services using the CODE_DEPLOY or EXTERNAL deployment controllers don't report a rollout state.
+		if primary != nil {
+			if v := aws.StringValue(primary.RolloutState); v != "" && v != ecs.DeploymentRolloutStateCompleted {
+				return service, serviceStatusPending, nil
+			}
+		}
 
 		if d, dc, rc := len(service.Deployments),
 			aws.Int64Value(service.DesiredCount),
@@ -96,6 +124,26 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 	}
 }
 
+func primaryDeployment(service *ecs.Service) *ecs.Deployment {
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == deploymentStatusPrimary {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
+func findDeployment(service *ecs.Service, id string) *ecs.Deployment {
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Id) == id {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
 func stabilityStatusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &ecs.DescribeTaskSetsInput{
@@ -5,11 +5,16 @@ package ecs
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -75,22 +80,54 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		input.Cluster = aws.String(cluster)
 	}
 
+	var deploymentID string
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster, &deploymentID),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
+		var use *retry.UnexpectedStateError
+		if errors.As(err, &use) && use.State == serviceStatusFailed {
+			tfresource.SetLastError(err, serviceDeploymentError(ctx, conn, v, deploymentID))
+		}
+
 		return v, err
 	}
 
 	return nil, err
 }
 
+// serviceDeploymentError returns an error describing why the specified deployment did not complete,
+// including the reasons its tasks were stopped.
+func serviceDeploymentError(ctx context.Context, conn *ecs.ECS, service *ecs.Service, deploymentID string) error {
+	var err error
+
+	if deployment := findDeployment(service, deploymentID); deployment != nil && aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+		err = fmt.Errorf("deployment (%s) failed: %s", deploymentID, aws.StringValue(deployment.RolloutStateReason))
+	} else if primary := primaryDeployment(service); primary != nil {
+		err = fmt.Errorf("deployment (%s) replaced by deployment (%s): %s", deploymentID, aws.StringValue(primary.Id), aws.StringValue(primary.RolloutStateReason))
+	} else {
+		err = fmt.Errorf("deployment (%s) replaced", deploymentID)
+	}
+
+	reasons, findErr := findStoppedTaskReasonsByDeploymentID(ctx, conn, aws.StringValue(service.ClusterArn), deploymentID)
+
+	if findErr != nil {
+		log.Printf("[WARN] reading ECS Service (%s) deployment (%s) stopped tasks: %s", aws.StringValue(service.ServiceName), deploymentID, findErr)
+	}
+
+	if len(reasons) > 0 {
+		err = fmt.Errorf("%w; stopped task reasons: %s", err, strings.Join(reasons, "; "))
+	}
+
+	return err
+}
+
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
 func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
 	input := &ecs.DescribeServicesInput{
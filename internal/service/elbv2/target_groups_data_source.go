@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Target Groups")
+func newDataSourceTargetGroups(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTargetGroups{}, nil
+}
+
+const (
+	DSNameTargetGroups = "Target Groups Data Source"
+)
+
+type dataSourceTargetGroups struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTargetGroups) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_lb_target_groups"
+}
+
+func (d *dataSourceTargetGroups) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"load_balancer_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"target_groups": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[targetGroupSummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"port": schema.Int64Attribute{
+							Computed: true,
+						},
+						"protocol": schema.StringAttribute{
+							Computed: true,
+						},
+						"target_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"vpc_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTargetGroups) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ELBV2Conn(ctx)
+
+	var data dataSourceTargetGroupsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: flex.StringFromFramework(ctx, data.LoadBalancerARN),
+		Names:           flex.ExpandFrameworkStringList(ctx, data.Names),
+	}
+
+	targetGroups, err := findTargetGroups(ctx, conn, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ELBV2, create.ErrActionReading, DSNameTargetGroups, d.Meta().Region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var arns []string
+	var summaries []*targetGroupSummaryData
+
+	for _, v := range targetGroups {
+		arns = append(arns, aws.StringValue(v.TargetGroupArn))
+		summaries = append(summaries, &targetGroupSummaryData{
+			ARN:        flex.StringToFramework(ctx, v.TargetGroupArn),
+			Name:       flex.StringToFramework(ctx, v.TargetGroupName),
+			Port:       flex.Int64ToFramework(ctx, v.Port),
+			Protocol:   flex.StringToFramework(ctx, v.Protocol),
+			TargetType: flex.StringToFramework(ctx, v.TargetType),
+			VPCID:      flex.StringToFramework(ctx, v.VpcId),
+		})
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.ID = types.StringValue(d.Meta().Region)
+	data.TargetGroups = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceTargetGroupsData struct {
+	ARNs            types.List                                              `tfsdk:"arns"`
+	ID              types.String                                            `tfsdk:"id"`
+	LoadBalancerARN fwtypes.ARN                                             `tfsdk:"load_balancer_arn"`
+	Names           types.List                                              `tfsdk:"names"`
+	TargetGroups    fwtypes.ListNestedObjectValueOf[targetGroupSummaryData] `tfsdk:"target_groups"`
+}
+
+type targetGroupSummaryData struct {
+	ARN        types.String `tfsdk:"arn"`
+	Name       types.String `tfsdk:"name"`
+	Port       types.Int64  `tfsdk:"port"`
+	Protocol   types.String `tfsdk:"protocol"`
+	TargetType types.String `tfsdk:"target_type"`
+	VPCID      types.String `tfsdk:"vpc_id"`
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBV2TargetGroupsDataSource_names(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_target_groups.test"
+	resourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupsDataSourceConfig_names(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_groups.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_groups.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_groups.0.port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_groups.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_groups.0.target_type", "instance"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_groups.0.vpc_id", resourceName, "vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetGroupsDataSourceConfig_names(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 8080
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+}
+
+data "aws_lb_target_groups" "test" {
+  names = [aws_lb_target_group.test.name]
+}
+`, rName))
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Event Buses")
+func newDataSourceBuses(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceBuses{}, nil
+}
+
+const (
+	DSNameBuses = "Event Buses Data Source"
+)
+
+type dataSourceBuses struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceBuses) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_cloudwatch_event_buses"
+}
+
+func (d *dataSourceBuses) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"event_buses": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[busSummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceBuses) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().EventsConn(ctx)
+
+	var data dataSourceBusesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &eventbridge.ListEventBusesInput{
+		NamePrefix: flex.StringFromFramework(ctx, data.NamePrefix),
+	}
+
+	var arns, busNames []string
+	var summaries []*busSummaryData
+
+	// ListEventBuses has no SDK paginator, so use the generated one.
+	err := listEventBusesPages(ctx, conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EventBuses {
+			if v == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(v.Arn))
+			busNames = append(busNames, aws.StringValue(v.Name))
+			summaries = append(summaries, &busSummaryData{
+				ARN:  flex.StringToFramework(ctx, v.Arn),
+				Name: flex.StringToFramework(ctx, v.Name),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Events, create.ErrActionReading, DSNameBuses, data.NamePrefix.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.EventBuses = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+	data.ID = types.StringValue(d.Meta().Region)
+	data.Names = flex.FlattenFrameworkStringValueList(ctx, busNames)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceBusesData struct {
+	ARNs       types.List                                      `tfsdk:"arns"`
+	EventBuses fwtypes.ListNestedObjectValueOf[busSummaryData] `tfsdk:"event_buses"`
+	ID         types.String                                    `tfsdk:"id"`
+	NamePrefix types.String                                    `tfsdk:"name_prefix"`
+	Names      types.List                                      `tfsdk:"names"`
+}
+
+type busSummaryData struct {
+	ARN  types.String `tfsdk:"arn"`
+	Name types.String `tfsdk:"name"`
+}
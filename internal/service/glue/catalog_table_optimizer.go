@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_glue_catalog_table_optimizer")
+func ResourceCatalogTableOptimizer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCatalogTableOptimizerCreate,
+		ReadWithoutTimeout:   resourceCatalogTableOptimizerRead,
+		UpdateWithoutTimeout: resourceCatalogTableOptimizerUpdate,
+		DeleteWithoutTimeout: resourceCatalogTableOptimizerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"last_run": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(glue.TableOptimizerType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceCatalogTableOptimizerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	optimizerType := d.Get("type").(string)
+	id := createTableOptimizerID(catalogID, dbName, tableName, optimizerType)
+
+	input := &glue.CreateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get("configuration").([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	// The optimizer role may not be assumable by Glue immediately after creation.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateTableOptimizerWithContext(ctx, input)
+	}, glue.ErrCodeAccessDeniedException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glue Catalog Table Optimizer (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	output, err := FindTableOptimizer(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Catalog Table Optimizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	if err := d.Set("configuration", flattenTableOptimizerConfiguration(output.TableOptimizer.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set("database_name", output.DatabaseName)
+	if err := d.Set("last_run", flattenTableOptimizerRun(output.TableOptimizer.LastRun)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting last_run: %s", err)
+	}
+	d.Set("table_name", output.TableName)
+	d.Set("type", output.TableOptimizer.Type)
+
+	return diags
+}
+
+func resourceCatalogTableOptimizerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	if d.HasChange("configuration") {
+		catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &glue.UpdateTableOptimizerInput{
+			CatalogId:                   aws.String(catalogID),
+			DatabaseName:                aws.String(dbName),
+			TableName:                   aws.String(tableName),
+			TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get("configuration").([]interface{})),
+			Type:                        aws.String(optimizerType),
+		}
+
+		_, err = conn.UpdateTableOptimizerWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Table Optimizer: %s", d.Id())
+	_, err = conn.DeleteTableOptimizerWithContext(ctx, &glue.DeleteTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandTableOptimizerConfiguration(l []interface{}) *glue.TableOptimizerConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	apiObject := &glue.TableOptimizerConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTableOptimizerConfiguration(apiObject *glue.TableOptimizerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":  aws.BoolValue(apiObject.Enabled),
+		"role_arn": aws.StringValue(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTableOptimizerRun(apiObject *glue.TableOptimizerRun) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"error":      aws.StringValue(apiObject.Error),
+		"event_type": aws.StringValue(apiObject.EventType),
+	}
+
+	if v := apiObject.EndTimestamp; v != nil {
+		tfMap["end_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTimestamp; v != nil {
+		tfMap["start_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
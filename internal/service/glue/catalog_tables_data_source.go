@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_glue_catalog_tables")
+func DataSourceCatalogTables() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCatalogTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCatalogTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get("database_name").(string)
+
+	input := &glue.GetTablesInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+	}
+
+	if v, ok := d.GetOk("expression"); ok {
+		input.Expression = aws.String(v.(string))
+	}
+
+	tables, err := findTables(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Tables (%s:%s): %s", catalogID, dbName, err)
+	}
+
+	var arns, names []string
+
+	for _, table := range tables {
+		name := aws.StringValue(table.Name)
+		tableARN := arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "glue",
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Resource:  fmt.Sprintf("table/%s/%s", dbName, name),
+		}.String()
+
+		arns = append(arns, tableARN)
+		names = append(names, name)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", catalogID, dbName))
+	d.Set("arns", arns)
+	d.Set("catalog_id", catalogID)
+	d.Set("names", names)
+
+	return diags
+}
+
+func findTables(ctx context.Context, conn *glue.Glue, input *glue.GetTablesInput) ([]*glue.TableData, error) {
+	var output []*glue.TableData
+
+	err := conn.GetTablesPagesWithContext(ctx, input, func(page *glue.GetTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TableList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGlueCatalogTablesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_glue_catalog_tables.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTablesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTablesDataSource_expression(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_glue_catalog_tables.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTablesDataSourceConfig_expression(rName, "orders.*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "aws_glue_catalog_table.test.0", "name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "aws_glue_catalog_table.test.1", "name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_glue_catalog_table.test.0", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCatalogTablesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  count = 2
+
+  database_name = aws_glue_catalog_database.test.name
+  name          = "orders_${count.index}"
+}
+
+resource "aws_glue_catalog_table" "other" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = "customers"
+}
+`, rName)
+}
+
+func testAccCatalogTablesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTablesDataSourceConfig_base(rName), `
+data "aws_glue_catalog_tables" "test" {
+  database_name = aws_glue_catalog_database.test.name
+
+  depends_on = [aws_glue_catalog_table.test, aws_glue_catalog_table.other]
+}
+`)
+}
+
+func testAccCatalogTablesDataSourceConfig_expression(rName, expression string) string {
+	return acctest.ConfigCompose(testAccCatalogTablesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_glue_catalog_tables" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  expression    = %[1]q
+
+  depends_on = [aws_glue_catalog_table.test, aws_glue_catalog_table.other]
+}
+`, expression))
+}
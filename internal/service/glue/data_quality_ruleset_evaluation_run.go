@@ -0,0 +1,461 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_glue_data_quality_ruleset_evaluation_run")
+func ResourceDataQualityRulesetEvaluationRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataQualityRulesetEvaluationRunCreate,
+		ReadWithoutTimeout:   resourceDataQualityRulesetEvaluationRunRead,
+		DeleteWithoutTimeout: resourceDataQualityRulesetEvaluationRunDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_run_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"results_s3_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_table": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"additional_options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"connection_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"database_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"table_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"execution_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"number_of_workers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"result_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"result_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_results": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"evaluated_metrics": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeFloat},
+									},
+									"evaluation_message": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"result": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ruleset_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"ruleset_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDataQualityRulesetEvaluationRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	input := &glue.StartDataQualityRulesetEvaluationRunInput{
+		DataSource:   expandDataQualityDataSource(d.Get("data_source").([]interface{})),
+		Role:         aws.String(d.Get("role").(string)),
+		RulesetNames: flex.ExpandStringList(d.Get("ruleset_names").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("additional_run_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AdditionalRunOptions = expandDataQualityEvaluationRunAdditionalRunOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("timeout"); ok {
+		input.Timeout = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.StartDataQualityRulesetEvaluationRunWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Glue Data Quality Ruleset Evaluation Run: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.RunId))
+
+	if _, err := waitDataQualityRulesetEvaluationRunCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Glue Data Quality Ruleset Evaluation Run (%s) to complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataQualityRulesetEvaluationRunRead(ctx, d, meta)...)
+}
+
+func resourceDataQualityRulesetEvaluationRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	run, err := FindDataQualityRulesetEvaluationRunByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Data Quality Ruleset Evaluation Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Ruleset Evaluation Run (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("additional_run_options", flattenDataQualityEvaluationRunAdditionalRunOptions(run.AdditionalRunOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting additional_run_options: %s", err)
+	}
+	if run.CompletedOn != nil {
+		d.Set("completed_on", aws.TimeValue(run.CompletedOn).Format(time.RFC3339))
+	} else {
+		d.Set("completed_on", nil)
+	}
+	if err := d.Set("data_source", flattenDataQualityDataSource(run.DataSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_source: %s", err)
+	}
+	d.Set("execution_time", run.ExecutionTime)
+	d.Set("number_of_workers", run.NumberOfWorkers)
+	d.Set("result_ids", aws.StringValueSlice(run.ResultIds))
+	d.Set("role", run.Role)
+	d.Set("ruleset_names", aws.StringValueSlice(run.RulesetNames))
+	if run.StartedOn != nil {
+		d.Set("started_on", aws.TimeValue(run.StartedOn).Format(time.RFC3339))
+	} else {
+		d.Set("started_on", nil)
+	}
+	d.Set("status", run.Status)
+	d.Set("timeout", run.Timeout)
+
+	var results []interface{}
+	for _, id := range aws.StringValueSlice(run.ResultIds) {
+		result, err := FindDataQualityResultByID(ctx, conn, id)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Result (%s): %s", id, err)
+		}
+
+		results = append(results, flattenDataQualityResult(result))
+	}
+	if err := d.Set("results", results); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataQualityRulesetEvaluationRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	// Completed runs cannot be deleted; only a run that is still in progress is cancelled.
+	switch d.Get("status").(string) {
+	case glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Glue Data Quality Ruleset Evaluation Run: %s", d.Id())
+	_, err := conn.CancelDataQualityRulesetEvaluationRunWithContext(ctx, &glue.CancelDataQualityRulesetEvaluationRunInput{
+		RunId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Glue Data Quality Ruleset Evaluation Run (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandDataQualityDataSource(l []interface{}) *glue.DataSource {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	apiObject := &glue.DataSource{}
+
+	if v, ok := tfMap["glue_table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GlueTable = expandDataQualityGlueTable(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDataQualityGlueTable(tfMap map[string]interface{}) *glue.Table {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.Table{}
+
+	if v, ok := tfMap["additional_options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.AdditionalOptions = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["connection_name"].(string); ok && v != "" {
+		apiObject.ConnectionName = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataQualityEvaluationRunAdditionalRunOptions(tfMap map[string]interface{}) *glue.DataQualityEvaluationRunAdditionalRunOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.DataQualityEvaluationRunAdditionalRunOptions{}
+
+	if v, ok := tfMap["cloudwatch_metrics_enabled"].(bool); ok {
+		apiObject.CloudWatchMetricsEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["results_s3_prefix"].(string); ok && v != "" {
+		apiObject.ResultsS3Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataQualityDataSource(apiObject *glue.DataSource) []interface{} {
+	if apiObject == nil || apiObject.GlueTable == nil {
+		return nil
+	}
+
+	table := apiObject.GlueTable
+	tfMap := map[string]interface{}{
+		"additional_options": aws.StringValueMap(table.AdditionalOptions),
+		"catalog_id":         aws.StringValue(table.CatalogId),
+		"connection_name":    aws.StringValue(table.ConnectionName),
+		"database_name":      aws.StringValue(table.DatabaseName),
+		"table_name":         aws.StringValue(table.TableName),
+	}
+
+	return []interface{}{map[string]interface{}{
+		"glue_table": []interface{}{tfMap},
+	}}
+}
+
+func flattenDataQualityEvaluationRunAdditionalRunOptions(apiObject *glue.DataQualityEvaluationRunAdditionalRunOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cloudwatch_metrics_enabled": aws.BoolValue(apiObject.CloudWatchMetricsEnabled),
+		"results_s3_prefix":          aws.StringValue(apiObject.ResultsS3Prefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDataQualityResult(apiObject *glue.GetDataQualityResultOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var ruleResults []interface{}
+	for _, v := range apiObject.RuleResults {
+		if v == nil {
+			continue
+		}
+
+		ruleResults = append(ruleResults, map[string]interface{}{
+			"description":        aws.StringValue(v.Description),
+			"evaluated_metrics":  aws.Float64ValueMap(v.EvaluatedMetrics),
+			"evaluation_message": aws.StringValue(v.EvaluationMessage),
+			"name":               aws.StringValue(v.Name),
+			"result":             aws.StringValue(v.Result),
+		})
+	}
+
+	return map[string]interface{}{
+		"result_id":    aws.StringValue(apiObject.ResultId),
+		"rule_results": ruleResults,
+		"ruleset_name": aws.StringValue(apiObject.RulesetName),
+		"score":        aws.Float64Value(apiObject.Score),
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+)
+
+func TestAccGlueDataQualityRulesetEvaluationRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset_evaluation_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetEvaluationRunConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataQualityRulesetEvaluationRunExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", glue.TaskStatusTypeSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "ruleset_names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "ruleset_names.0", "aws_glue_data_quality_ruleset.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "data_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_source.0.glue_table.0.database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "data_source.0.glue_table.0.table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "result_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "results.0.ruleset_name", "aws_glue_data_quality_ruleset.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.rule_results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.rule_results.0.result", glue.DataQualityRuleResultStatusPass),
+					resource.TestCheckResourceAttrSet(resourceName, "started_on"),
+					resource.TestCheckResourceAttrSet(resourceName, "completed_on"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func testAccCheckDataQualityRulesetEvaluationRunExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn(ctx)
+
+		_, err := tfglue.FindDataQualityRulesetEvaluationRunByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDataQualityRulesetEvaluationRunConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/data.csv"
+  content = "id,name\n1,a\n2,b\n"
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  parameters = {
+    "classification"         = "csv"
+    "skip.header.line.count" = "1"
+  }
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.bucket}/data/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe"
+
+      parameters = {
+        "field.delim" = ","
+      }
+    }
+
+    columns {
+      name = "id"
+      type = "int"
+    }
+
+    columns {
+      name = "name"
+      type = "string"
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = "Rules = [IsComplete \"id\"]"
+
+  target_table {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+
+resource "aws_glue_data_quality_ruleset_evaluation_run" "test" {
+  role          = aws_iam_role.test.arn
+  ruleset_names = [aws_glue_data_quality_ruleset.test.name]
+
+  data_source {
+    glue_table {
+      database_name = aws_glue_catalog_database.test.name
+      table_name    = aws_glue_catalog_table.test.name
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_iam_role_policy.test]
+}
+`, rName)
+}
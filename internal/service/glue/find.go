@@ -112,6 +112,54 @@ func FindDataQualityRulesetByName(ctx context.Context, conn *glue.Glue, name str
 	return output, nil
 }
 
+func FindDataQualityRulesetEvaluationRunByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetDataQualityRulesetEvaluationRunOutput, error) {
+	input := &glue.GetDataQualityRulesetEvaluationRunInput{
+		RunId: aws.String(id),
+	}
+
+	output, err := conn.GetDataQualityRulesetEvaluationRunWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDataQualityResultByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetDataQualityResultOutput, error) {
+	input := &glue.GetDataQualityResultInput{
+		ResultId: aws.String(id),
+	}
+
+	output, err := conn.GetDataQualityResultWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 // FindTriggerByName returns the Trigger corresponding to the specified name.
 func FindTriggerByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetTriggerOutput, error) {
 	input := &glue.GetTriggerInput{
@@ -276,6 +324,40 @@ func FindPartitionIndexByName(ctx context.Context, conn *glue.Glue, id string) (
 	return result, nil
 }
 
+// FindTableOptimizer returns the Table Optimizer corresponding to the specified ID.
+func FindTableOptimizer(ctx context.Context, conn *glue.Glue, id string) (*glue.GetTableOptimizerOutput, error) {
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &glue.GetTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	}
+
+	output, err := conn.GetTableOptimizerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableOptimizer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindClassifierByName(ctx context.Context, conn *glue.Glue, name string) (*glue.Classifier, error) {
 	input := &glue.GetClassifierInput{
 		Name: aws.String(name),
@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCatalogTable,
 			TypeName: "aws_glue_catalog_table",
 		},
+		{
+			Factory:  DataSourceCatalogTables,
+			TypeName: "aws_glue_catalog_tables",
+		},
 		{
 			Factory:  DataSourceConnection,
 			TypeName: "aws_glue_connection",
@@ -58,6 +62,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCatalogTable,
 			TypeName: "aws_glue_catalog_table",
 		},
+		{
+			Factory:  ResourceCatalogTableOptimizer,
+			TypeName: "aws_glue_catalog_table_optimizer",
+		},
 		{
 			Factory:  ResourceClassifier,
 			TypeName: "aws_glue_classifier",
@@ -90,6 +98,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceDataQualityRulesetEvaluationRun,
+			TypeName: "aws_glue_data_quality_ruleset_evaluation_run",
+		},
 		{
 			Factory:  ResourceDevEndpoint,
 			TypeName: "aws_glue_dev_endpoint",
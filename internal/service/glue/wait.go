@@ -233,3 +233,25 @@ func waitPartitionIndexDeleted(ctx context.Context, conn *glue.Glue, id string,
 
 	return nil, err
 }
+
+func waitDataQualityRulesetEvaluationRunCompleted(ctx context.Context, conn *glue.Glue, id string, timeout time.Duration) (*glue.GetDataQualityRulesetEvaluationRunOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning, glue.TaskStatusTypeStopping},
+		Target:  []string{glue.TaskStatusTypeSucceeded},
+		Refresh: statusDataQualityRulesetEvaluationRun(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*glue.GetDataQualityRulesetEvaluationRunOutput); ok {
+		if v := output.ErrorString; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -17,5 +17,8 @@ var (
 	FindAttachedUserPolicies            = findAttachedUserPolicies
 	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
 	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
+	FindGroupPolicyNames                = findGroupPolicyNames
 	FindPolicyByARN                     = findPolicyByARN
+	FindRolePolicyNames                 = findRolePolicyNames
+	FindUserPolicyNames                 = findUserPolicyNames
 )
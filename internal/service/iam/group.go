@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -183,6 +184,101 @@ func FindGroupByName(ctx context.Context, conn *iam.IAM, name string) (*iam.Grou
 	return output.Group, nil
 }
 
+func findGroupAttachedPolicies(ctx context.Context, conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	err := conn.ListAttachedGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttachedPolicies {
+			if v != nil {
+				output = append(output, aws.StringValue(v.PolicyArn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findGroupPolicyNames(ctx context.Context, conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	err := conn.ListGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PolicyNames {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func deleteGroupInlinePolicies(ctx context.Context, conn *iam.IAM, groupName string, policyNames []string) error {
+	var errs []error
+
+	for _, policyName := range policyNames {
+		if len(policyName) == 0 {
+			continue
+		}
+
+		input := &iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(policyName),
+			GroupName:  aws.String(groupName),
+		}
+
+		_, err := conn.DeleteGroupPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting IAM Group (%s) policy (%s): %w", groupName, policyName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func DeleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string) error {
 	var attachedPolicies []*iam.AttachedPolicy
 	input := &iam.ListAttachedGroupPoliciesInput{
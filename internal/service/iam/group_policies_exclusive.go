@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Group Policies Exclusive")
+func newResourceGroupPoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceGroupPoliciesExclusive{}, nil
+}
+
+const (
+	ResNameGroupPoliciesExclusive = "Group Policies Exclusive"
+)
+
+type resourceGroupPoliciesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceGroupPoliciesExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_iam_group_policies_exclusive"
+}
+
+func (r *resourceGroupPoliciesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceGroupPoliciesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceGroupPoliciesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := plan.GroupName.ValueString()
+	if err := r.syncPolicies(ctx, groupName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyNames)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameGroupPoliciesExclusive, groupName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(groupName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceGroupPoliciesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().IAMConn(ctx)
+
+	var state resourceGroupPoliciesExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := state.ID.ValueString()
+	policyNames, err := findGroupPolicyNames(ctx, conn, groupName)
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameGroupPoliciesExclusive, groupName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, policyNames)
+	state.GroupName = types.StringValue(groupName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceGroupPoliciesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceGroupPoliciesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := plan.GroupName.ValueString()
+	if err := r.syncPolicies(ctx, groupName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyNames)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameGroupPoliciesExclusive, groupName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state. The remaining inline policies
+// are left in place.
+func (r *resourceGroupPoliciesExclusive) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// syncPolicies deletes any inline policy on the group that is not in the configured set.
+// Inline policies are created by aws_iam_group_policy, so configured names are not added here.
+func (r *resourceGroupPoliciesExclusive) syncPolicies(ctx context.Context, groupName string, want flex.Set[string]) error {
+	conn := r.Meta().IAMConn(ctx)
+
+	have, err := findGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteGroupInlinePolicies(ctx, conn, groupName, flex.Set[string](have).Difference(want))
+}
+
+type resourceGroupPoliciesExclusiveData struct {
+	ID          types.String `tfsdk:"id"`
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	GroupName   types.String `tfsdk:"group_name"`
+}
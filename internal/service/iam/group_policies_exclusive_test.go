@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMGroupPoliciesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policies_exclusive.test"
+	groupResourceName := "aws_iam_group.test"
+	policyResourceName := "aws_iam_group_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, rName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", groupResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_names.*", policyResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPoliciesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	outOfBandPolicyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, rName, 1),
+					testAccCheckGroupPoliciesExclusivePutPolicy(ctx, rName, outOfBandPolicyName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPoliciesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	outOfBandPolicyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, rName, 0),
+					testAccCheckGroupPoliciesExclusivePutPolicy(ctx, rName, outOfBandPolicyName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, rName, 0),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupPoliciesExclusiveCount(ctx context.Context, groupName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		policyNames, err := tfiam.FindGroupPolicyNames(ctx, conn, groupName)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(policyNames); got != want {
+			return fmt.Errorf("IAM Group (%s) has %d inline policies, want %d", groupName, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupPoliciesExclusivePutPolicy(ctx context.Context, groupName, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		input := &iam.PutGroupPolicyInput{
+			PolicyDocument: aws.String(`{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "ec2:Describe*",
+    "Resource": "*"
+  }
+}`),
+			PolicyName: aws.String(policyName),
+			GroupName:  aws.String(groupName),
+		}
+
+		_, err := conn.PutGroupPolicyWithContext(ctx, input)
+
+		return err
+	}
+}
+
+func testAccGroupPoliciesExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccGroupPoliciesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGroupPoliciesExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_group_policy" "test" {
+  name  = %[1]q
+  group = aws_iam_group.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group_policies_exclusive" "test" {
+  group_name   = aws_iam_group.test.name
+  policy_names = [aws_iam_group_policy.test.name]
+}
+`, rName))
+}
+
+func testAccGroupPoliciesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccGroupPoliciesExclusiveConfig_base(rName), `
+resource "aws_iam_group_policies_exclusive" "test" {
+  group_name   = aws_iam_group.test.name
+  policy_names = []
+}
+`)
+}
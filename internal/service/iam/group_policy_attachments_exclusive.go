@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Group Policy Attachments Exclusive")
+func newResourceGroupPolicyAttachmentsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceGroupPolicyAttachmentsExclusive{}, nil
+}
+
+const (
+	ResNameGroupPolicyAttachmentsExclusive = "Group Policy Attachments Exclusive"
+)
+
+type resourceGroupPolicyAttachmentsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceGroupPolicyAttachmentsExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_iam_group_policy_attachments_exclusive"
+}
+
+func (r *resourceGroupPolicyAttachmentsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceGroupPolicyAttachmentsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceGroupPolicyAttachmentsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := plan.GroupName.ValueString()
+	if err := r.syncAttachments(ctx, groupName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyARNs)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameGroupPolicyAttachmentsExclusive, groupName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(groupName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceGroupPolicyAttachmentsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().IAMConn(ctx)
+
+	var state resourceGroupPolicyAttachmentsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := state.ID.ValueString()
+	policyARNs, err := findGroupAttachedPolicies(ctx, conn, groupName)
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameGroupPolicyAttachmentsExclusive, groupName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.PolicyARNs = flex.FlattenFrameworkStringValueSetLegacy(ctx, policyARNs)
+	state.GroupName = types.StringValue(groupName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceGroupPolicyAttachmentsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceGroupPolicyAttachmentsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := plan.GroupName.ValueString()
+	if err := r.syncAttachments(ctx, groupName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyARNs)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameGroupPolicyAttachmentsExclusive, groupName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state. The remaining attachments
+// are left in place.
+func (r *resourceGroupPolicyAttachmentsExclusive) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// syncAttachments attaches any configured policy that is missing from the group and
+// detaches any attached policy that is not configured.
+func (r *resourceGroupPolicyAttachmentsExclusive) syncAttachments(ctx context.Context, groupName string, want flex.Set[string]) error {
+	conn := r.Meta().IAMConn(ctx)
+
+	policyARNs, err := findGroupAttachedPolicies(ctx, conn, groupName)
+
+	if err != nil {
+		return err
+	}
+
+	have := flex.Set[string](policyARNs)
+
+	var errs []error
+
+	for _, policyARN := range want.Difference(have) {
+		if err := attachPolicyToGroup(ctx, conn, groupName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, policyARN := range have.Difference(want) {
+		if err := detachPolicyFromGroup(ctx, conn, groupName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type resourceGroupPolicyAttachmentsExclusiveData struct {
+	ID         types.String `tfsdk:"id"`
+	PolicyARNs types.Set    `tfsdk:"policy_arns"`
+	GroupName  types.String `tfsdk:"group_name"`
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+	groupResourceName := "aws_iam_group.test"
+	policyResourceName := "aws_iam_policy.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, rName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", groupResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", policyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, rName, 1),
+					testAccCheckGroupPolicyAttachmentsExclusiveAttach(ctx, rName, "aws_iam_policy.test2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx context.Context, groupName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		input := &iam.ListAttachedGroupPoliciesInput{
+			GroupName: aws.String(groupName),
+		}
+		attachedPolicies, err := tfiam.FindAttachedGroupPolicies(ctx, conn, input, tfslices.PredicateTrue[*iam.AttachedPolicy]())
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(attachedPolicies); got != want {
+			return fmt.Errorf("IAM Group (%s) has %d attached policies, want %d", groupName, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupPolicyAttachmentsExclusiveAttach(ctx context.Context, groupName, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		_, err := conn.AttachGroupPolicyWithContext(ctx, &iam.AttachGroupPolicyInput{
+			PolicyArn: aws.String(rs.Primary.Attributes["arn"]),
+			GroupName: aws.String(groupName),
+		})
+
+		return err
+	}
+}
+
+func testAccGroupPolicyAttachmentsExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_policy" "test1" {
+  name = "%[1]s-1"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_policy" "test2" {
+  name = "%[1]s-2"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListAllMyBuckets"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGroupPolicyAttachmentsExclusiveConfig_base(rName), `
+resource "aws_iam_group_policy_attachments_exclusive" "test" {
+  group_name  = aws_iam_group.test.name
+  policy_arns = [aws_iam_policy.test1.arn]
+}
+`)
+}
+
+func testAccGroupPolicyAttachmentsExclusiveConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccGroupPolicyAttachmentsExclusiveConfig_base(rName), `
+resource "aws_iam_group_policy_attachments_exclusive" "test" {
+  group_name  = aws_iam_group.test.name
+  policy_arns = [aws_iam_policy.test1.arn, aws_iam_policy.test2.arn]
+}
+`)
+}
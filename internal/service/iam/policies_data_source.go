@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Policies")
+func newDataSourcePolicies(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourcePolicies{}, nil
+}
+
+const (
+	DSNamePolicies = "Policies Data Source"
+)
+
+type dataSourcePolicies struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourcePolicies) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_iam_policies"
+}
+
+func (d *dataSourcePolicies) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"only_attached": schema.BoolAttribute{
+				Optional: true,
+			},
+			"path_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"policy_usage_filter": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(iam.PolicyUsageType_Values()...),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(iam.PolicyScopeType_Values()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"policies": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policySummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						"attachment_count": schema.Int64Attribute{
+							Computed: true,
+						},
+						"default_version_id": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"path": schema.StringAttribute{
+							Computed: true,
+						},
+						"policy_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourcePolicies) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().IAMConn(ctx)
+
+	var data dataSourcePoliciesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &iam.ListPoliciesInput{
+		OnlyAttached:      flex.BoolFromFramework(ctx, data.OnlyAttached),
+		PathPrefix:        flex.StringFromFramework(ctx, data.PathPrefix),
+		PolicyUsageFilter: flex.StringFromFramework(ctx, data.PolicyUsageFilter),
+		Scope:             flex.StringFromFramework(ctx, data.Scope),
+	}
+
+	policies, err := findPolicies(ctx, conn, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, DSNamePolicies, d.Meta().AccountID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var arns, policyNames []string
+	var summaries []*policySummaryData
+
+	for _, v := range policies {
+		arns = append(arns, aws.StringValue(v.Arn))
+		policyNames = append(policyNames, aws.StringValue(v.PolicyName))
+		summaries = append(summaries, &policySummaryData{
+			ARN:              flex.StringToFramework(ctx, v.Arn),
+			AttachmentCount:  flex.Int64ToFramework(ctx, v.AttachmentCount),
+			DefaultVersionID: flex.StringToFramework(ctx, v.DefaultVersionId),
+			Name:             flex.StringToFramework(ctx, v.PolicyName),
+			Path:             flex.StringToFramework(ctx, v.Path),
+			PolicyID:         flex.StringToFramework(ctx, v.PolicyId),
+		})
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.ID = types.StringValue(d.Meta().AccountID)
+	data.Names = flex.FlattenFrameworkStringValueList(ctx, policyNames)
+	data.Policies = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourcePoliciesData struct {
+	ARNs              types.List                                         `tfsdk:"arns"`
+	ID                types.String                                       `tfsdk:"id"`
+	Names             types.List                                         `tfsdk:"names"`
+	OnlyAttached      types.Bool                                         `tfsdk:"only_attached"`
+	PathPrefix        types.String                                       `tfsdk:"path_prefix"`
+	Policies          fwtypes.ListNestedObjectValueOf[policySummaryData] `tfsdk:"policies"`
+	PolicyUsageFilter types.String                                       `tfsdk:"policy_usage_filter"`
+	Scope             types.String                                       `tfsdk:"scope"`
+}
+
+type policySummaryData struct {
+	ARN              types.String `tfsdk:"arn"`
+	AttachmentCount  types.Int64  `tfsdk:"attachment_count"`
+	DefaultVersionID types.String `tfsdk:"default_version_id"`
+	Name             types.String `tfsdk:"name"`
+	Path             types.String `tfsdk:"path"`
+	PolicyID         types.String `tfsdk:"policy_id"`
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPoliciesDataSource_pathPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_iam_policies.test"
+	resourceName := "aws_iam_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_pathPrefix(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "names.0", resourceName, "name"),
+					resource.TestCheckResourceAttr(datasourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "policies.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "policies.0.attachment_count", "0"),
+					resource.TestCheckResourceAttr(datasourceName, "policies.0.default_version_id", "v1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "policies.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "policies.0.path", resourceName, "path"),
+					resource.TestCheckResourceAttrPair(datasourceName, "policies.0.policy_id", resourceName, "policy_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoliciesDataSourceConfig_pathPrefix(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+  path = "/%[1]s/"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+data "aws_iam_policies" "test" {
+  scope       = "Local"
+  path_prefix = aws_iam_policy.test.path
+}
+`, rName)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Role Policies Exclusive")
+func newResourceRolePoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceRolePoliciesExclusive{}, nil
+}
+
+const (
+	ResNameRolePoliciesExclusive = "Role Policies Exclusive"
+)
+
+type resourceRolePoliciesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceRolePoliciesExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_iam_role_policies_exclusive"
+}
+
+func (r *resourceRolePoliciesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceRolePoliciesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceRolePoliciesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := plan.RoleName.ValueString()
+	if err := r.syncPolicies(ctx, roleName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyNames)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameRolePoliciesExclusive, roleName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(roleName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceRolePoliciesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().IAMConn(ctx)
+
+	var state resourceRolePoliciesExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.ID.ValueString()
+	policyNames, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameRolePoliciesExclusive, roleName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, policyNames)
+	state.RoleName = types.StringValue(roleName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceRolePoliciesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceRolePoliciesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := plan.RoleName.ValueString()
+	if err := r.syncPolicies(ctx, roleName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyNames)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameRolePoliciesExclusive, roleName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state. The remaining inline policies
+// are left in place.
+func (r *resourceRolePoliciesExclusive) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// syncPolicies deletes any inline policy on the role that is not in the configured set.
+// Inline policies are created by aws_iam_role_policy, so configured names are not added here.
+func (r *resourceRolePoliciesExclusive) syncPolicies(ctx context.Context, roleName string, want flex.Set[string]) error {
+	conn := r.Meta().IAMConn(ctx)
+
+	have, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteRoleInlinePolicies(ctx, conn, roleName, flex.Set[string](have).Difference(want))
+}
+
+type resourceRolePoliciesExclusiveData struct {
+	ID          types.String `tfsdk:"id"`
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	RoleName    types.String `tfsdk:"role_name"`
+}
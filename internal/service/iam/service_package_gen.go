@@ -16,11 +16,41 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourcePolicies,
+			Name:    "Policies",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceGroupPoliciesExclusive,
+			Name:    "Group Policies Exclusive",
+		},
+		{
+			Factory: newResourceGroupPolicyAttachmentsExclusive,
+			Name:    "Group Policy Attachments Exclusive",
+		},
+		{
+			Factory: newResourceRolePoliciesExclusive,
+			Name:    "Role Policies Exclusive",
+		},
+		{
+			Factory: newResourceRolePolicyAttachmentsExclusive,
+			Name:    "Role Policy Attachments Exclusive",
+		},
+		{
+			Factory: newResourceUserPoliciesExclusive,
+			Name:    "User Policies Exclusive",
+		},
+		{
+			Factory: newResourceUserPolicyAttachmentsExclusive,
+			Name:    "User Policy Attachments Exclusive",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -314,6 +315,101 @@ func FindUserByName(ctx context.Context, conn *iam.IAM, name string) (*iam.User,
 	return output.User, nil
 }
 
+func findUserAttachedPolicies(ctx context.Context, conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+	var output []string
+
+	err := conn.ListAttachedUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttachedPolicies {
+			if v != nil {
+				output = append(output, aws.StringValue(v.PolicyArn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findUserPolicyNames(ctx context.Context, conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+	var output []string
+
+	err := conn.ListUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PolicyNames {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func deleteUserInlinePolicies(ctx context.Context, conn *iam.IAM, userName string, policyNames []string) error {
+	var errs []error
+
+	for _, policyName := range policyNames {
+		if len(policyName) == 0 {
+			continue
+		}
+
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(policyName),
+			UserName:   aws.String(userName),
+		}
+
+		_, err := conn.DeleteUserPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting IAM User (%s) policy (%s): %w", userName, policyName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func DeleteUserGroupMemberships(ctx context.Context, conn *iam.IAM, username string) error {
 	var groups []string
 	listGroups := &iam.ListGroupsForUserInput{
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="User Policies Exclusive")
+func newResourceUserPoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceUserPoliciesExclusive{}, nil
+}
+
+const (
+	ResNameUserPoliciesExclusive = "User Policies Exclusive"
+)
+
+type resourceUserPoliciesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceUserPoliciesExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_iam_user_policies_exclusive"
+}
+
+func (r *resourceUserPoliciesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"user_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceUserPoliciesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceUserPoliciesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userName := plan.UserName.ValueString()
+	if err := r.syncPolicies(ctx, userName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyNames)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameUserPoliciesExclusive, userName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(userName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceUserPoliciesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().IAMConn(ctx)
+
+	var state resourceUserPoliciesExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userName := state.ID.ValueString()
+	policyNames, err := findUserPolicyNames(ctx, conn, userName)
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameUserPoliciesExclusive, userName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, policyNames)
+	state.UserName = types.StringValue(userName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceUserPoliciesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceUserPoliciesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userName := plan.UserName.ValueString()
+	if err := r.syncPolicies(ctx, userName, flex.ExpandFrameworkStringValueSet(ctx, plan.PolicyNames)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameUserPoliciesExclusive, userName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state. The remaining inline policies
+// are left in place.
+func (r *resourceUserPoliciesExclusive) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// syncPolicies deletes any inline policy on the user that is not in the configured set.
+// Inline policies are created by aws_iam_user_policy, so configured names are not added here.
+func (r *resourceUserPoliciesExclusive) syncPolicies(ctx context.Context, userName string, want flex.Set[string]) error {
+	conn := r.Meta().IAMConn(ctx)
+
+	have, err := findUserPolicyNames(ctx, conn, userName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteUserInlinePolicies(ctx, conn, userName, flex.Set[string](have).Difference(want))
+}
+
+type resourceUserPoliciesExclusiveData struct {
+	ID          types.String `tfsdk:"id"`
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	UserName    types.String `tfsdk:"user_name"`
+}
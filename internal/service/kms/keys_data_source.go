@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Keys")
+func newDataSourceKeys(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceKeys{}, nil
+}
+
+const (
+	DSNameKeys = "Keys Data Source"
+)
+
+type dataSourceKeys struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceKeys) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_kms_keys"
+}
+
+func (d *dataSourceKeys) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"keys": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[keySummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"aliases": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrID: schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceKeys) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().KMSConn(ctx)
+
+	var data dataSourceKeysData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := findKeys(ctx, conn, &kms.ListKeysInput{})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionReading, DSNameKeys, d.Meta().Region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Aliases are listed once for the whole region and grouped by target key.
+	aliases, err := findAliases(ctx, conn, &kms.ListAliasesInput{})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionReading, DSNameKeys, d.Meta().Region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	aliasesByKeyID := make(map[string][]string)
+	for _, v := range aliases {
+		if keyID := aws.StringValue(v.TargetKeyId); keyID != "" {
+			aliasesByKeyID[keyID] = append(aliasesByKeyID[keyID], aws.StringValue(v.AliasName))
+		}
+	}
+
+	var arns, ids []string
+	var summaries []*keySummaryData
+
+	for _, v := range keys {
+		keyID := aws.StringValue(v.KeyId)
+
+		arns = append(arns, aws.StringValue(v.KeyArn))
+		ids = append(ids, keyID)
+		summaries = append(summaries, &keySummaryData{
+			Aliases: flex.FlattenFrameworkStringValueList(ctx, aliasesByKeyID[keyID]),
+			ARN:     flex.StringToFramework(ctx, v.KeyArn),
+			ID:      flex.StringToFramework(ctx, v.KeyId),
+		})
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.ID = types.StringValue(d.Meta().Region)
+	data.IDs = flex.FlattenFrameworkStringValueList(ctx, ids)
+	data.Keys = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findKeys(ctx context.Context, conn *kms.KMS, input *kms.ListKeysInput) ([]*kms.KeyListEntry, error) {
+	var output []*kms.KeyListEntry
+
+	err := conn.ListKeysPagesWithContext(ctx, input, func(page *kms.ListKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Keys {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAliases(ctx context.Context, conn *kms.KMS, input *kms.ListAliasesInput) ([]*kms.AliasListEntry, error) {
+	var output []*kms.AliasListEntry
+
+	err := conn.ListAliasesPagesWithContext(ctx, input, func(page *kms.ListAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Aliases {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type dataSourceKeysData struct {
+	ARNs types.List                                      `tfsdk:"arns"`
+	ID   types.String                                    `tfsdk:"id"`
+	IDs  types.List                                      `tfsdk:"ids"`
+	Keys fwtypes.ListNestedObjectValueOf[keySummaryData] `tfsdk:"keys"`
+}
+
+type keySummaryData struct {
+	Aliases types.List   `tfsdk:"aliases"`
+	ARN     types.String `tfsdk:"arn"`
+	ID      types.String `tfsdk:"id"`
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSKeysDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_key.test"
+	aliasResourceName := "aws_kms_alias.test"
+	dataSourceName := "data.aws_kms_keys.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "key_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "keys.*.id", resourceName, "key_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "keys.*.aliases.*", aliasResourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeysDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.test.id
+}
+
+data "aws_kms_keys" "test" {
+  depends_on = [aws_kms_alias.test]
+}
+`, rName)
+}
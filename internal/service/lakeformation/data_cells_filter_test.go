@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDataCellsFilter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.name", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, "table_data.0.table_catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "table_data.0.column_names.*", "event"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "table_data.0.column_names.*", "timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.all_rows_wildcard", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "table_data.0.version_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceDataCellsFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_columnWildcard(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_columnWildcard(rName, `"timestamp"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.*", "timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataCellsFilterConfig_columnWildcard(rName, `"timestamp", "transactionamount"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.*", "timestamp"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.*", "transactionamount"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_rowFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_rowFilter(rName, "event = 'click'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.all_rows_wildcard", "false"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "event = 'click'"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataCellsFilterConfig_rowFilter(rName, "transactionamount > 100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "transactionamount > 100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataCellsFilterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_data_cells_filter" {
+				continue
+			}
+
+			_, err := tflakeformation.FindDataCellsFilterByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation Data Cells Filter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataCellsFilterExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		_, err := tflakeformation.FindDataCellsFilterByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDataCellsFilterConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "transactionamount"
+      type = "double"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDataCellsFilterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_data_cells_filter" "test" {
+  table_data {
+    database_name    = aws_glue_catalog_database.test.name
+    name             = %[1]q
+    table_catalog_id = data.aws_caller_identity.current.account_id
+    table_name       = aws_glue_catalog_table.test.name
+    column_names     = ["event", "timestamp"]
+
+    row_filter {
+      all_rows_wildcard = true
+    }
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
+
+func testAccDataCellsFilterConfig_columnWildcard(rName, excludedColumns string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_data_cells_filter" "test" {
+  table_data {
+    database_name    = aws_glue_catalog_database.test.name
+    name             = %[1]q
+    table_catalog_id = data.aws_caller_identity.current.account_id
+    table_name       = aws_glue_catalog_table.test.name
+
+    column_wildcard {
+      excluded_column_names = [%[2]s]
+    }
+
+    row_filter {
+      all_rows_wildcard = true
+    }
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, excludedColumns))
+}
+
+func testAccDataCellsFilterConfig_rowFilter(rName, filterExpression string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_data_cells_filter" "test" {
+  table_data {
+    database_name    = aws_glue_catalog_database.test.name
+    name             = %[1]q
+    table_catalog_id = data.aws_caller_identity.current.account_id
+    table_name       = aws_glue_catalog_table.test.name
+    column_names     = ["event", "transactionamount"]
+
+    row_filter {
+      filter_expression = %[2]q
+    }
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, filterExpression))
+}
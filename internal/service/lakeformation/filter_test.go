@@ -539,6 +539,88 @@ func TestFilterPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "dataCellsFilter",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					DataCellsFilter: &lakeformation.DataCellsFilterResource{
+						DatabaseName:   aws.String(dbName),
+						Name:           aws.String("Fiduhan"),
+						TableCatalogId: aws.String(accountID),
+						TableName:      aws.String(tableName),
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String("Fiduhan"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String("Zemogaj"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String("Fiduhan"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String("Ridaseju"),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						Table: &lakeformation.TableResource{
+							CatalogId:    aws.String(accountID),
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String(tableName),
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String("Fiduhan"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String(tableName),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -13,6 +13,12 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataCellsFilter": {
+			"basic":          testAccDataCellsFilter_basic,
+			"columnWildcard": testAccDataCellsFilter_columnWildcard,
+			"disappears":     testAccDataCellsFilter_disappears,
+			"rowFilter":      testAccDataCellsFilter_rowFilter,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"disappears":       testAccDataLakeSettings_disappears,
@@ -23,8 +29,14 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"basic":          testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"OptIn": {
+			"basic":           testAccOptIn_basic,
+			"dataCellsFilter": testAccOptIn_dataCellsFilter,
+			"disappears":      testAccOptIn_disappears,
+		},
 		"PermissionsBasic": {
 			"basic":               testAccPermissions_basic,
+			"dataCellsFilter":     testAccPermissions_dataCellsFilter,
 			"database":            testAccPermissions_database,
 			"databaseIAMAllowed":  testAccPermissions_databaseIAMAllowed,
 			"databaseMultiple":    testAccPermissions_databaseMultiple,
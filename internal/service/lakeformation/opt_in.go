@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lakeformation_opt_in")
+func ResourceOptIn() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOptInCreate,
+		ReadWithoutTimeout:   resourceOptInRead,
+		DeleteWithoutTimeout: resourceOptInDelete,
+
+		Schema: map[string]*schema.Schema{
+			"data_cells_filter": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"data_cells_filter",
+					"data_location",
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"table_catalog_id": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"data_location": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"data_cells_filter",
+					"data_location",
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"data_cells_filter",
+					"data_location",
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validPrincipal,
+			},
+			"table": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"data_cells_filter",
+					"data_location",
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOptInCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	principal := &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+	}
+	resource := expandOptInResource(d, meta.(*conns.AWSClient).AccountID)
+	input := &lakeformation.CreateLakeFormationOptInInput{
+		Principal: principal,
+		Resource:  resource,
+	}
+
+	// Newly created IAM principals may not be visible to Lake Formation immediately.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, IAMPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateLakeFormationOptInWithContext(ctx, input)
+	}, lakeformation.ErrCodeInvalidInputException, "Invalid principal")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Opt-In (%s): %s", input, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(input.String())))
+
+	return append(diags, resourceOptInRead(ctx, d, meta)...)
+}
+
+func resourceOptInRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	principal := &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+	}
+	resource := expandOptInResource(d, meta.(*conns.AWSClient).AccountID)
+
+	optIn, err := FindOptIn(ctx, conn, principal, resource)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Opt-In (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Opt-In (%s): %s", d.Id(), err)
+	}
+
+	if optIn.LastModified != nil {
+		d.Set("last_modified", aws.TimeValue(optIn.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("last_updated_by", optIn.LastUpdatedBy)
+	d.Set("principal", optIn.Principal.DataLakePrincipalIdentifier)
+
+	if v := optIn.Resource; v != nil {
+		if v.DataCellsFilter != nil {
+			if err := d.Set("data_cells_filter", []interface{}{flattenDataCellsFilterResource(v.DataCellsFilter)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting data_cells_filter: %s", err)
+			}
+		}
+
+		if v.DataLocation != nil {
+			if err := d.Set("data_location", []interface{}{flattenDataLocationResource(v.DataLocation)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting data_location: %s", err)
+			}
+		}
+
+		if v.Database != nil {
+			if err := d.Set("database", []interface{}{flattenDatabaseResource(v.Database)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting database: %s", err)
+			}
+		}
+
+		if v.Table != nil {
+			if err := d.Set("table", []interface{}{flattenTableResource(v.Table)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting table: %s", err)
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceOptInDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	input := &lakeformation.DeleteLakeFormationOptInInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandOptInResource(d, meta.(*conns.AWSClient).AccountID),
+	}
+
+	log.Printf("[INFO] Deleting Lake Formation Opt-In: %s", d.Id())
+	_, err := conn.DeleteLakeFormationOptInWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Opt-In (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindOptIn(ctx context.Context, conn *lakeformation.LakeFormation, principal *lakeformation.DataLakePrincipal, resource *lakeformation.Resource) (*lakeformation.LakeFormationOptInsInfo, error) {
+	input := &lakeformation.ListLakeFormationOptInsInput{
+		Principal: principal,
+		Resource:  resource,
+	}
+	var output []*lakeformation.LakeFormationOptInsInfo
+
+	err := conn.ListLakeFormationOptInsPagesWithContext(ctx, input, func(page *lakeformation.ListLakeFormationOptInsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LakeFormationOptInsInfoList {
+			if v == nil || v.Principal == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Principal.DataLakePrincipalIdentifier) != aws.StringValue(principal.DataLakePrincipalIdentifier) {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output[0], nil
+}
+
+func expandOptInResource(d *schema.ResourceData, accountID string) *lakeformation.Resource {
+	resource := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("data_cells_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		resource.DataCellsFilter = ExpandDataCellsFilterResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+
+		if resource.DataLocation.CatalogId == nil {
+			resource.DataLocation.CatalogId = aws.String(accountID)
+		}
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		resource.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+
+		if resource.Database.CatalogId == nil {
+			resource.Database.CatalogId = aws.String(accountID)
+		}
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		resource.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+
+		if resource.Table.CatalogId == nil {
+			resource.Table.CatalogId = aws.String(accountID)
+		}
+	}
+
+	return resource
+}
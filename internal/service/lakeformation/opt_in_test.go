@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccOptIn_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+	roleName := "aws_iam_role.test"
+	dbName := "aws_glue_catalog_database.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", dbName, "name"),
+					acctest.CheckResourceAttrAccountID(resourceName, "database.0.catalog_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_by"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOptIn_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceOptIn(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOptIn_dataCellsFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+	filterName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_dataCellsFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_cells_filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.0.name", filterName, "table_data.0.name"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.0.table_name", filterName, "table_data.0.table_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOptInDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_opt_in" {
+				continue
+			}
+
+			_, err := tflakeformation.FindOptIn(ctx, conn, testAccOptInPrincipal(rs), testAccOptInResource(rs))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation Opt-In %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOptInExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		_, err := tflakeformation.FindOptIn(ctx, conn, testAccOptInPrincipal(rs), testAccOptInResource(rs))
+
+		return err
+	}
+}
+
+func testAccOptInPrincipal(rs *terraform.ResourceState) *lakeformation.DataLakePrincipal {
+	return &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes["principal"]),
+	}
+}
+
+func testAccOptInResource(rs *terraform.ResourceState) *lakeformation.Resource {
+	resource := &lakeformation.Resource{}
+
+	if v := rs.Primary.Attributes["data_cells_filter.#"]; v != "" && v != "0" {
+		resource.DataCellsFilter = tflakeformation.ExpandDataCellsFilterResource(map[string]interface{}{
+			"database_name":    rs.Primary.Attributes["data_cells_filter.0.database_name"],
+			"name":             rs.Primary.Attributes["data_cells_filter.0.name"],
+			"table_catalog_id": rs.Primary.Attributes["data_cells_filter.0.table_catalog_id"],
+			"table_name":       rs.Primary.Attributes["data_cells_filter.0.table_name"],
+		})
+	}
+
+	if v := rs.Primary.Attributes["data_location.#"]; v != "" && v != "0" {
+		resource.DataLocation = tflakeformation.ExpandDataLocationResource(map[string]interface{}{
+			"arn":        rs.Primary.Attributes["data_location.0.arn"],
+			"catalog_id": rs.Primary.Attributes["data_location.0.catalog_id"],
+		})
+	}
+
+	if v := rs.Primary.Attributes["database.#"]; v != "" && v != "0" {
+		resource.Database = tflakeformation.ExpandDatabaseResource(map[string]interface{}{
+			"catalog_id": rs.Primary.Attributes["database.0.catalog_id"],
+			"name":       rs.Primary.Attributes["database.0.name"],
+		})
+	}
+
+	if v := rs.Primary.Attributes["table.#"]; v != "" && v != "0" {
+		resource.Table = tflakeformation.ExpandTableResource(map[string]interface{}{
+			"catalog_id":    rs.Primary.Attributes["table.0.catalog_id"],
+			"database_name": rs.Primary.Attributes["table.0.database_name"],
+			"name":          rs.Primary.Attributes["table.0.name"],
+		})
+	}
+
+	return resource
+}
+
+func testAccOptInConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccOptInConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOptInConfig_base(rName), `
+resource "aws_lakeformation_opt_in" "test" {
+  principal = aws_iam_role.test.arn
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccOptInConfig_dataCellsFilter(rName string) string {
+	return acctest.ConfigCompose(testAccOptInConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+  }
+}
+
+resource "aws_lakeformation_data_cells_filter" "test" {
+  table_data {
+    database_name    = aws_glue_catalog_database.test.name
+    name             = %[1]q
+    table_catalog_id = data.aws_caller_identity.current.account_id
+    table_name       = aws_glue_catalog_table.test.name
+    column_names     = ["event"]
+
+    row_filter {
+      all_rows_wildcard = true
+    }
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_opt_in" "test" {
+  principal = aws_iam_role.test.arn
+
+  data_cells_filter {
+    database_name    = aws_lakeformation_data_cells_filter.test.table_data[0].database_name
+    name             = aws_lakeformation_data_cells_filter.test.table_data[0].name
+    table_catalog_id = aws_lakeformation_data_cells_filter.test.table_data[0].table_catalog_id
+    table_name       = aws_lakeformation_data_cells_filter.test.table_data[0].table_name
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
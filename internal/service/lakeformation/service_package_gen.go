@@ -42,6 +42,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceDataCellsFilter,
+			TypeName: "aws_lakeformation_data_cells_filter",
+		},
 		{
 			Factory:  ResourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
@@ -50,6 +54,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
 		},
+		{
+			Factory:  ResourceOptIn,
+			TypeName: "aws_lakeformation_opt_in",
+		},
 		{
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
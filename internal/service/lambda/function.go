@@ -169,7 +169,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +208,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -324,7 +324,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -335,7 +335,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -378,6 +378,23 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_dir_staging_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -456,6 +473,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			setSourceDirCodeHash,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -499,6 +517,21 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := packageSourceDir(ctx, d, meta, functionName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+		}
+
+		defer code.cleanup(ctx, meta)
+
+		input.Code.S3Bucket = code.S3Bucket
+		input.Code.S3Key = code.S3Key
+		input.Code.ZipFile = code.ZipFile
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -962,6 +995,25 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := packageSourceDir(ctx, d, meta, d.Id())
+
+			if err != nil {
+				// As the planned source_code_hash hasn't been deployed, don't ovewrite the last known good value.
+				old, _ := d.GetChange("source_code_hash")
+				d.Set("source_code_hash", old)
+
+				return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+			}
+
+			defer code.cleanup(ctx, meta)
+
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.ZipFile = code.ZipFile
 		} else {
 			input.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -982,6 +1034,11 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				}
 			}
 
+			if _, ok := d.GetOk("source_dir"); ok {
+				old, _ := d.GetChange("source_code_hash")
+				d.Set("source_code_hash", old)
+			}
+
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
 		}
 
@@ -1288,6 +1345,8 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("source_dir") ||
+		d.HasChange("source_dir_excludes") ||
 		d.HasChange("architectures")
 }
 
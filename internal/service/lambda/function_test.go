@@ -1902,6 +1902,74 @@ func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	sourceDir := t.TempDir()
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopyFileToSourceDir(t, "test-fixtures/lambda_func.js", sourceDir, "lambda.js")
+					testAccCopyFileToSourceDir(t, "test-fixtures/lambda_invocation.js", sourceDir, "excluded/lambda_invocation.js")
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(value string) error {
+						return testAccCheckSourceCodeHash(&conf, value)(nil)
+					}),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", sourceDir),
+					resource.TestCheckResourceAttr(resourceName, "source_dir_excludes.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_dir_excludes"},
+			},
+			{
+				// Changes to excluded files don't change the deployment package.
+				PreConfig: func() {
+					testAccCopyFileToSourceDir(t, "test-fixtures/lambda_func_modified.js", sourceDir, "excluded/lambda_invocation.js")
+				},
+				Config:   testAccFunctionConfig_sourceDir(sourceDir, rName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccCopyFileToSourceDir(t, "test-fixtures/lambda_func_modified.js", sourceDir, "lambda.js")
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(value string) error {
+						return testAccCheckSourceCodeHash(&conf, value)(nil)
+					}),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2437,6 +2505,23 @@ func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash
 	}
 }
 
+func testAccCopyFileToSourceDir(t *testing.T, src, sourceDir, name string) {
+	t.Helper()
+
+	content, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := filepath.Join(sourceDir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dst, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key string, before time.Time) error {
 	rs, ok := s.RootModule().Resources[name]
 	if !ok {
@@ -3823,6 +3908,21 @@ resource "aws_lambda_function" "test" {
 `, filePath, rName)
 }
 
+func testAccFunctionConfig_sourceDir(sourceDir, rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir          = %[1]q
+  source_dir_excludes = ["excluded"]
+  function_name       = %[2]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "lambda.handler"
+  runtime             = "nodejs16.x"
+}
+`, sourceDir, rName))
+}
+
 func testAccFunctionConfig_localNameOnly(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
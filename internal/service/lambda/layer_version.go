@@ -37,6 +37,8 @@ func ResourceLayerVersion() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setSourceDirCodeHash,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -76,7 +78,7 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -97,19 +99,19 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -135,6 +137,25 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_dir_staging_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -152,13 +173,27 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 	s3Bucket, bucketOk := d.GetOk("s3_bucket")
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *lambda.LayerVersionContentInput
-	if hasFilename {
+	if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+		code, err := packageSourceDir(ctx, d, meta, layerName)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", sourceDir.(string), err)
+		}
+		defer code.cleanup(ctx, meta)
+		layerContent = &lambda.LayerVersionContentInput{
+			S3Bucket: code.S3Bucket,
+			S3Key:    code.S3Key,
+			ZipFile:  code.ZipFile,
+		}
+	} else if hasFilename {
 		conns.GlobalMutexKV.Lock(mutexLayerKey)
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 		file, err := readFileContents(filename.(string))
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly through the Lambda API.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	directUploadMaxSize = 50 * 1024 * 1024
+)
+
+var (
+	// All archive entries carry the same modification time so that the archive
+	// content depends only on the file names, modes and contents.
+	sourceDirModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// setSourceDirCodeHash is a CustomizeDiff function that builds the deployment package
+// for a configured source_dir and plans its hash as the new source_code_hash.
+func setSourceDirCodeHash(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	v, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil
+	}
+
+	zipFile, err := buildSourceDirZip(v.(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return fmt.Errorf("packaging source directory (%s): %w", v, err)
+	}
+
+	if hash := sourceCodeHash(zipFile); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
+// sourceDirCode is a deployment package built from a source_dir argument.
+// Packages too large for direct upload are staged as an S3 object.
+type sourceDirCode struct {
+	S3Bucket *string
+	S3Key    *string
+	ZipFile  []byte
+}
+
+// packageSourceDir builds the deployment package for the specified resource data
+// and, if it exceeds the direct upload limit, uploads it to the staging bucket
+// under a key derived from the specified name and the package's hash.
+func packageSourceDir(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) (*sourceDirCode, error) {
+	zipFile, err := buildSourceDirZip(d.Get("source_dir").(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zipFile) <= directUploadMaxSize {
+		return &sourceDirCode{
+			ZipFile: zipFile,
+		}, nil
+	}
+
+	bucket := d.Get("source_dir_staging_bucket").(string)
+	if bucket == "" {
+		return nil, fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes) and source_dir_staging_bucket is not set", len(zipFile), directUploadMaxSize)
+	}
+
+	sum := sha256.Sum256(zipFile)
+	key := fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(sum[:]))
+
+	_, err = meta.(*conns.AWSClient).S3Client(ctx).PutObject(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(zipFile),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading deployment package to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return &sourceDirCode{
+		S3Bucket: aws.String(bucket),
+		S3Key:    aws.String(key),
+	}, nil
+}
+
+// cleanup removes any staged S3 object. Lambda copies the deployment package when
+// the function or layer version is created, so the object is no longer needed.
+func (c *sourceDirCode) cleanup(ctx context.Context, meta interface{}) {
+	if c == nil || c.S3Key == nil {
+		return
+	}
+
+	_, err := meta.(*conns.AWSClient).S3Client(ctx).DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: c.S3Bucket,
+		Key:    c.S3Key,
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Deleting staged Lambda deployment package (s3://%s/%s): %s", aws.ToString(c.S3Bucket), aws.ToString(c.S3Key), err)
+	}
+}
+
+// buildSourceDirZip returns a ZIP archive of all regular files below dir whose slash-separated
+// relative paths, or any of their parent directories, do not match one of the exclude patterns.
+// Patterns use path.Match syntax. The archive is reproducible: entries are sorted by path and
+// carry a fixed modification time and a normalized mode.
+func buildSourceDirZip(dir string, excludes []string) ([]byte, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern (%s): %w", pattern, err)
+		}
+	}
+
+	var files []string
+	err = filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if sourceDirExcluded(rel, excludes) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		files = append(files, rel)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files found in %s", dir)
+	}
+
+	sort.Strings(files)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range files {
+		if err := addSourceDirFile(w, filepath.Join(dir, filepath.FromSlash(name)), name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func addSourceDirFile(w *zip.Writer, filename, name string) error {
+	// Stat follows symbolic links so that linked files are archived by content.
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", filename)
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: sourceDirModTime,
+	}
+	// Only the executable bit is preserved. Lambda runtimes such as custom runtimes
+	// require an executable bootstrap file.
+	if info.Mode().Perm()&0o111 != 0 {
+		header.SetMode(0o755)
+	} else {
+		header.SetMode(0o644)
+	}
+
+	fw, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(fw, f)
+
+	return err
+}
+
+func sourceDirExcluded(name string, excludes []string) bool {
+	for _, pattern := range excludes {
+		for p := name; p != "." && p != "/"; p = path.Dir(p) {
+			if ok, _ := path.Match(pattern, p); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sourceCodeHash returns the hash of a deployment package in the format used by the Lambda API's CodeSha256.
+func sourceCodeHash(zipFile []byte) string {
+	sum := sha256.Sum256(zipFile)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeSourceDirFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func sourceDirZipEntries(t *testing.T, zipFile []byte) []string {
+	t.Helper()
+
+	r, err := zip.NewReader(bytes.NewReader(zipFile), int64(len(zipFile)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+
+	return names
+}
+
+func TestBuildSourceDirZip_reproducible(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":         "exports.handler = async () => 'ok';",
+		"lib/util.js":      "module.exports = {};",
+		"lib/deep/data.js": "module.exports = [];",
+	}
+
+	dir1, dir2 := t.TempDir(), t.TempDir()
+	writeSourceDirFiles(t, dir1, files)
+	writeSourceDirFiles(t, dir2, files)
+
+	// Same content with different modification times.
+	mtime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	for name := range files {
+		if err := os.Chtimes(filepath.Join(dir2, filepath.FromSlash(name)), mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	zip1, err := buildSourceDirZip(dir1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zip1Again, err := buildSourceDirZip(dir1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zip2, err := buildSourceDirZip(dir2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(zip1, zip1Again) {
+		t.Error("repeated builds of the same directory differ")
+	}
+
+	if !bytes.Equal(zip1, zip2) {
+		t.Error("builds of identical directories with different modification times differ")
+	}
+
+	if got, want := sourceCodeHash(zip1), sourceCodeHash(zip2); got != want {
+		t.Errorf("sourceCodeHash = %q, want %q", got, want)
+	}
+}
+
+func TestBuildSourceDirZip_entries(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFiles(t, dir, map[string]string{
+		"z.txt":       "z",
+		"a.txt":       "a",
+		"m/b.txt":     "b",
+		"m/a.txt":     "a",
+		"bootstrap":   "#!/bin/sh",
+		"README.md":   "readme",
+		"tests/a.js":  "test",
+		"lib/test.js": "test",
+	})
+
+	if err := os.Chmod(filepath.Join(dir, "bootstrap"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	zipFile, err := buildSourceDirZip(dir, []string{"*.md", "tests", "lib/test.*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"a.txt", "bootstrap", "m/a.txt", "m/b.txt", "z.txt"}
+	if diff := cmp.Diff(sourceDirZipEntries(t, zipFile), want); diff != "" {
+		t.Errorf("unexpected entries (+want, -got): %s", diff)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(zipFile), int64(len(zipFile)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range r.File {
+		if !f.Modified.Equal(sourceDirModTime) {
+			t.Errorf("%s: Modified = %s, want %s", f.Name, f.Modified, sourceDirModTime)
+		}
+
+		wantMode := os.FileMode(0o644)
+		if f.Name == "bootstrap" {
+			wantMode = 0o755
+		}
+		if got := f.Mode().Perm(); got != wantMode {
+			t.Errorf("%s: mode = %s, want %s", f.Name, got, wantMode)
+		}
+	}
+}
+
+func TestBuildSourceDirZip_contentChange(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFiles(t, dir, map[string]string{
+		"index.js": "exports.handler = async () => 'ok';",
+	})
+
+	zip1, err := buildSourceDirZip(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeSourceDirFiles(t, dir, map[string]string{
+		"index.js": "exports.handler = async () => 'changed';",
+	})
+
+	zip2, err := buildSourceDirZip(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sourceCodeHash(zip1) == sourceCodeHash(zip2) {
+		t.Error("expected source code hash to change with file content")
+	}
+}
+
+func TestBuildSourceDirZip_errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFiles(t, dir, map[string]string{
+		"index.js": "exports.handler = async () => 'ok';",
+	})
+
+	if _, err := buildSourceDirZip(dir, []string{"["}); err == nil {
+		t.Error("expected error for invalid exclude pattern")
+	}
+
+	if _, err := buildSourceDirZip(dir, []string{"*"}); err == nil {
+		t.Error("expected error when all files are excluded")
+	}
+
+	if _, err := buildSourceDirZip(filepath.Join(dir, "missing"), nil); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
+
+func TestSourceDirExcluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		excludes []string
+		want     bool
+	}{
+		{name: "index.js", excludes: nil, want: false},
+		{name: "index.js", excludes: []string{"*.js"}, want: true},
+		{name: "lib/index.js", excludes: []string{"*.js"}, want: false},
+		{name: "lib/index.js", excludes: []string{"lib/*.js"}, want: true},
+		{name: "node_modules/a/index.js", excludes: []string{"node_modules"}, want: true},
+		{name: "src/node_modules/index.js", excludes: []string{"node_modules"}, want: false},
+		{name: ".git/config", excludes: []string{".git"}, want: true},
+		{name: "README.md", excludes: []string{"*.txt", "README*"}, want: true},
+	}
+
+	for _, testCase := range testCases {
+		if got := sourceDirExcluded(testCase.name, testCase.excludes); got != testCase.want {
+			t.Errorf("sourceDirExcluded(%q, %q) = %t, want %t", testCase.name, testCase.excludes, got, testCase.want)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
+// @Tags(identifierAttribute="arn")
+func resourceDeliverySource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliverySourceCreate,
+		ReadWithoutTimeout:   resourceDeliverySourceRead,
+		UpdateWithoutTimeout: resourceDeliverySourceUpdate,
+		DeleteWithoutTimeout: resourceDeliverySourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeliverySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &cloudwatchlogs.PutDeliverySourceInput{
+		LogType:     aws.String(d.Get("log_type").(string)),
+		Name:        aws.String(name),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.PutDeliverySource(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Logs Delivery Source (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DeliverySource.Name))
+
+	return append(diags, resourceDeliverySourceRead(ctx, d, meta)...)
+}
+
+func resourceDeliverySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	source, err := findDeliverySourceByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Delivery Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", source.Arn)
+	d.Set("log_type", source.LogType)
+	d.Set("name", source.Name)
+	if len(source.ResourceArns) > 0 {
+		d.Set("resource_arn", source.ResourceArns[0])
+	} else {
+		d.Set("resource_arn", nil)
+	}
+	d.Set("service", source.Service)
+
+	setTagsOut(ctx, source.Tags)
+
+	return diags
+}
+
+func resourceDeliverySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &cloudwatchlogs.PutDeliverySourceInput{
+			LogType:     aws.String(d.Get("log_type").(string)),
+			Name:        aws.String(d.Id()),
+			ResourceArn: aws.String(d.Get("resource_arn").(string)),
+		}
+
+		_, err := conn.PutDeliverySource(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Logs Delivery Source (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDeliverySourceRead(ctx, d, meta)...)
+}
+
+func resourceDeliverySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery Source: %s", d.Id())
+	_, err := conn.DeleteDeliverySource(ctx, &cloudwatchlogs.DeleteDeliverySourceInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Delivery Source (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeliverySourceByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*types.DeliverySource, error) {
+	input := &cloudwatchlogs.GetDeliverySourceInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDeliverySource(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeliverySource == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeliverySource, nil
+}
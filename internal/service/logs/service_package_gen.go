@@ -41,10 +41,52 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAccountPolicy,
+			TypeName: "aws_cloudwatch_log_account_policy",
+			Name:     "Account Policy",
+		},
+		{
+			Factory:  resourceAnomalyDetector,
+			TypeName: "aws_cloudwatch_log_anomaly_detector",
+			Name:     "Anomaly Detector",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  resourceDataProtectionPolicy,
 			TypeName: "aws_cloudwatch_log_data_protection_policy",
 		},
+		{
+			Factory:  resourceDelivery,
+			TypeName: "aws_cloudwatch_log_delivery",
+			Name:     "Delivery",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  resourceDeliveryDestination,
+			TypeName: "aws_cloudwatch_log_delivery_destination",
+			Name:     "Delivery Destination",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  resourceDeliveryDestinationPolicy,
+			TypeName: "aws_cloudwatch_log_delivery_destination_policy",
+			Name:     "Delivery Destination Policy",
+		},
+		{
+			Factory:  resourceDeliverySource,
+			TypeName: "aws_cloudwatch_log_delivery_source",
+			Name:     "Delivery Source",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  resourceDestination,
 			TypeName: "aws_cloudwatch_log_destination",
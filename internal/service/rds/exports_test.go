@@ -5,7 +5,10 @@ package rds
 
 // Exports for use in tests only.
 var (
-	FindDBInstanceByID = findDBInstanceByIDSDKv1
+	ResourceIntegration = newResourceIntegration
+
+	FindDBInstanceByID   = findDBInstanceByIDSDKv1
+	FindIntegrationByARN = findIntegrationByARN
 
 	ListTags = listTags
 
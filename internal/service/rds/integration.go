@@ -0,0 +1,575 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Integration")
+// @Tags(identifierAttribute="arn")
+func newResourceIntegration(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIntegration{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameIntegration = "Integration"
+
+	// Zero-ETL integrations require case-sensitive identifiers on the Redshift target.
+	redshiftParameterEnableCaseSensitiveIdentifier = "enable_case_sensitive_identifier"
+)
+
+type resourceIntegration struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceIntegration) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_rds_integration"
+}
+
+func (r *resourceIntegration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"integration_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RDSConn(ctx)
+
+	var data resourceIntegrationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &rds.CreateIntegrationInput{
+		AdditionalEncryptionContext: flex.ExpandFrameworkStringMap(ctx, data.AdditionalEncryptionContext),
+		IntegrationName:             flex.StringFromFramework(ctx, data.IntegrationName),
+		KMSKeyId:                    flex.StringFromFramework(ctx, data.KMSKeyID),
+		SourceArn:                   flex.StringFromFramework(ctx, data.SourceARN),
+		Tags:                        getTagsIn(ctx),
+		TargetArn:                   flex.StringFromFramework(ctx, data.TargetARN),
+	}
+
+	output, err := conn.CreateIntegrationWithContext(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionCreating, ResNameIntegration, data.IntegrationName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, output.IntegrationArn)
+	data.ID = data.ARN
+
+	integration, err := waitIntegrationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForCreation, ResNameIntegration, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.KMSKeyID = flex.StringToFramework(ctx, integration.KMSKeyId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RDSConn(ctx)
+
+	var data resourceIntegrationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := findIntegrationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"AWS Resource Not Found During Refresh",
+			fmt.Sprintf("Automatically removing from Terraform State instead of returning the error, which may trigger resource recreation. Original Error: %s", err.Error()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionSetting, ResNameIntegration, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AdditionalEncryptionContext = flex.FlattenFrameworkStringMap(ctx, integration.AdditionalEncryptionContext)
+	data.ARN = flex.StringToFramework(ctx, integration.IntegrationArn)
+	data.IntegrationName = flex.StringToFramework(ctx, integration.IntegrationName)
+	data.KMSKeyID = flex.StringToFramework(ctx, integration.KMSKeyId)
+	data.SourceARN = flex.StringToFrameworkARN(ctx, integration.SourceArn)
+	data.TargetARN = flex.StringToFrameworkARN(ctx, integration.TargetArn)
+
+	setTagsOut(ctx, integration.Tags)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var new resourceIntegrationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *resourceIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RDSConn(ctx)
+
+	var data resourceIntegrationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteIntegrationWithContext(ctx, &rds.DeleteIntegrationInput{
+		IntegrationIdentifier: aws.String(data.ID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeIntegrationNotFoundFault) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionDeleting, ResNameIntegration, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitIntegrationDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForDeletion, ResNameIntegration, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceIntegration) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+
+	// Only validate the target when the integration is being created or replaced.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceIntegrationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TargetARN.IsUnknown() || plan.TargetARN.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resourceIntegrationData
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.TargetARN.Equal(state.TargetARN) {
+			return
+		}
+	}
+
+	// The check reads the currently deployed Redshift configuration, which may be changed by the same apply
+	// (e.g. a parameter group update), so problems are reported as warnings rather than blocking the plan.
+	targetARN := plan.TargetARN.ValueString()
+	enabled, err := findIntegrationTargetCaseSensitivity(ctx, r.Meta(), targetARN)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("target_arn"),
+			"Unable to verify Redshift target configuration",
+			fmt.Sprintf("Could not check the %q parameter of zero-ETL integration target %s: %s", redshiftParameterEnableCaseSensitiveIdentifier, targetARN, err),
+		)
+		return
+	}
+
+	if !enabled {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("target_arn"),
+			"Invalid Redshift target configuration",
+			fmt.Sprintf("Zero-ETL integration target %s must have the %q parameter set to \"true\". "+
+				"Unless the parameter is changed before the integration is created, creation will fail.", targetARN, redshiftParameterEnableCaseSensitiveIdentifier),
+		)
+	}
+}
+
+type resourceIntegrationData struct {
+	AdditionalEncryptionContext types.Map      `tfsdk:"additional_encryption_context"`
+	ARN                         types.String   `tfsdk:"arn"`
+	ID                          types.String   `tfsdk:"id"`
+	IntegrationName             types.String   `tfsdk:"integration_name"`
+	KMSKeyID                    types.String   `tfsdk:"kms_key_id"`
+	SourceARN                   fwtypes.ARN    `tfsdk:"source_arn"`
+	Tags                        types.Map      `tfsdk:"tags"`
+	TagsAll                     types.Map      `tfsdk:"tags_all"`
+	TargetARN                   fwtypes.ARN    `tfsdk:"target_arn"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func findIntegrationByARN(ctx context.Context, conn *rds.RDS, arn string) (*rds.Integration, error) {
+	input := &rds.DescribeIntegrationsInput{
+		IntegrationIdentifier: aws.String(arn),
+	}
+
+	return findIntegration(ctx, conn, input)
+}
+
+func findIntegration(ctx context.Context, conn *rds.RDS, input *rds.DescribeIntegrationsInput) (*rds.Integration, error) {
+	output, err := findIntegrations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findIntegrations(ctx context.Context, conn *rds.RDS, input *rds.DescribeIntegrationsInput) ([]*rds.Integration, error) {
+	var output []*rds.Integration
+
+	err := conn.DescribeIntegrationsPagesWithContext(ctx, input, func(page *rds.DescribeIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Integrations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeIntegrationNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusIntegration(ctx context.Context, conn *rds.RDS, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIntegrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitIntegrationCreated(ctx context.Context, conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			rds.IntegrationStatusCreating,
+			rds.IntegrationStatusModifying,
+		},
+		Target: []string{
+			rds.IntegrationStatusActive,
+		},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationErrors(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			rds.IntegrationStatusDeleting,
+			rds.IntegrationStatusActive,
+		},
+		Target:  []string{},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationErrors(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func integrationErrors(apiObjects []*rds.IntegrationError) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// findIntegrationTargetCaseSensitivity reports whether the Redshift namespace identified by targetARN
+// has case-sensitive identifiers enabled. Both provisioned clusters and Serverless workgroups are supported.
+func findIntegrationTargetCaseSensitivity(ctx context.Context, meta *conns.AWSClient, targetARN string) (bool, error) {
+	parsedARN, err := arn.Parse(targetARN)
+	if err != nil {
+		return false, err
+	}
+
+	switch parsedARN.Service {
+	case redshift.EndpointsID:
+		return findRedshiftClusterCaseSensitivity(ctx, meta.RedshiftConn(ctx), targetARN)
+	case redshiftserverless.EndpointsID:
+		return findRedshiftServerlessCaseSensitivity(ctx, meta.RedshiftServerlessConn(ctx), targetARN)
+	default:
+		return false, fmt.Errorf("unsupported integration target service: %s", parsedARN.Service)
+	}
+}
+
+func findRedshiftClusterCaseSensitivity(ctx context.Context, conn *redshift.Redshift, namespaceARN string) (bool, error) {
+	var cluster *redshift.Cluster
+
+	err := conn.DescribeClustersPagesWithContext(ctx, &redshift.DescribeClustersInput{}, func(page *redshift.DescribeClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Clusters {
+			if v != nil && aws.StringValue(v.ClusterNamespaceArn) == namespaceARN {
+				cluster = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	if cluster == nil || len(cluster.ClusterParameterGroups) == 0 {
+		return false, tfresource.NewEmptyResultError(namespaceARN)
+	}
+
+	var value string
+	input := &redshift.DescribeClusterParametersInput{
+		ParameterGroupName: cluster.ClusterParameterGroups[0].ParameterGroupName,
+	}
+
+	err = conn.DescribeClusterParametersPagesWithContext(ctx, input, func(page *redshift.DescribeClusterParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil && aws.StringValue(v.ParameterName) == redshiftParameterEnableCaseSensitiveIdentifier {
+				value = aws.StringValue(v.ParameterValue)
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(value, "true"), nil
+}
+
+func findRedshiftServerlessCaseSensitivity(ctx context.Context, conn *redshiftserverless.RedshiftServerless, namespaceARN string) (bool, error) {
+	var namespaceName string
+
+	err := conn.ListNamespacesPagesWithContext(ctx, &redshiftserverless.ListNamespacesInput{}, func(page *redshiftserverless.ListNamespacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Namespaces {
+			if v != nil && aws.StringValue(v.NamespaceArn) == namespaceARN {
+				namespaceName = aws.StringValue(v.NamespaceName)
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	if namespaceName == "" {
+		return false, tfresource.NewEmptyResultError(namespaceARN)
+	}
+
+	var workgroup *redshiftserverless.Workgroup
+
+	err = conn.ListWorkgroupsPagesWithContext(ctx, &redshiftserverless.ListWorkgroupsInput{}, func(page *redshiftserverless.ListWorkgroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Workgroups {
+			if v != nil && aws.StringValue(v.NamespaceName) == namespaceName {
+				workgroup = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	if workgroup == nil {
+		return false, tfresource.NewEmptyResultError(namespaceName)
+	}
+
+	for _, v := range workgroup.ConfigParameters {
+		if v != nil && aws.StringValue(v.ParameterKey) == redshiftParameterEnableCaseSensitiveIdentifier {
+			return strings.EqualFold(aws.StringValue(v.ParameterValue), "true"), nil
+		}
+	}
+
+	return false, nil
+}
@@ -102,3 +102,38 @@ func expandTxtEntry(s string) string {
 func flattenTxtEntry(s string) string {
 	return fmt.Sprintf(`"%s"`, s)
 }
+
+func flattenAliasTarget(apiObject *route53.AliasTarget) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"evaluate_target_health": aws.BoolValue(apiObject.EvaluateTargetHealth),
+		"name":                   NormalizeAliasName(aws.StringValue(apiObject.DNSName)),
+		"zone_id":                aws.StringValue(apiObject.HostedZoneId),
+	}
+}
+
+func flattenCIDRRoutingConfig(apiObject *route53.CidrRoutingConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"collection_id": aws.StringValue(apiObject.CollectionId),
+		"location_name": aws.StringValue(apiObject.LocationName),
+	}
+}
+
+func flattenGeoLocation(apiObject *route53.GeoLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"continent":   aws.StringValue(apiObject.ContinentCode),
+		"country":     aws.StringValue(apiObject.CountryCode),
+		"subdivision": aws.StringValue(apiObject.SubdivisionCode),
+	}
+}
@@ -367,13 +367,8 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
 	}
 
-	if alias := record.AliasTarget; alias != nil {
-		name := NormalizeAliasName(aws.StringValue(alias.DNSName))
-		v := []map[string]interface{}{{
-			"zone_id":                aws.StringValue(alias.HostedZoneId),
-			"name":                   name,
-			"evaluate_target_health": aws.BoolValue(alias.EvaluateTargetHealth),
-		}}
+	if record.AliasTarget != nil {
+		v := []interface{}{flattenAliasTarget(record.AliasTarget)}
 		if err := d.Set("alias", v); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting alias: %s", err)
 		}
@@ -382,10 +377,7 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("ttl", record.TTL)
 
 	if record.CidrRoutingConfig != nil {
-		v := []map[string]interface{}{{
-			"collection_id": aws.StringValue(record.CidrRoutingConfig.CollectionId),
-			"location_name": aws.StringValue(record.CidrRoutingConfig.LocationName),
-		}}
+		v := []interface{}{flattenCIDRRoutingConfig(record.CidrRoutingConfig)}
 		if err := d.Set("cidr_routing_policy", v); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting cidr_routing_policy: %s", err)
 		}
@@ -401,11 +393,7 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if record.GeoLocation != nil {
-		v := []map[string]interface{}{{
-			"continent":   aws.StringValue(record.GeoLocation.ContinentCode),
-			"country":     aws.StringValue(record.GeoLocation.CountryCode),
-			"subdivision": aws.StringValue(record.GeoLocation.SubdivisionCode),
-		}}
+		v := []interface{}{flattenGeoLocation(record.GeoLocation)}
 		if err := d.Set("geolocation_routing_policy", v); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting geolocation_routing_policy: %s", err)
 		}
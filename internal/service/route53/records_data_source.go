@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @SDKDataSource("aws_route53_records")
+func DataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"set_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	filters := []tfslices.Predicate[*route53.ResourceRecordSet]{}
+	if v, ok := d.GetOk("name_regex"); ok {
+		r := regexache.MustCompile(v.(string))
+		filters = append(filters, func(v *route53.ResourceRecordSet) bool {
+			return r.MatchString(flattenRecordName(aws.StringValue(v.Name)))
+		})
+	}
+	if v, ok := d.GetOk("set_identifier"); ok {
+		setIdentifier := v.(string)
+		filters = append(filters, func(v *route53.ResourceRecordSet) bool {
+			return aws.StringValue(v.SetIdentifier) == setIdentifier
+		})
+	}
+	if v, ok := d.GetOk("type"); ok {
+		recordType := v.(string)
+		filters = append(filters, func(v *route53.ResourceRecordSet) bool {
+			return strings.ToUpper(aws.StringValue(v.Type)) == recordType
+		})
+	}
+
+	recordSets, err := findResourceRecordSets(ctx, conn, input, func(v *route53.ResourceRecordSet) bool {
+		for _, filter := range filters {
+			if !filter(v) {
+				return false
+			}
+		}
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	var names []string
+	for _, v := range recordSets {
+		names = append(names, flattenRecordName(aws.StringValue(v.Name)))
+	}
+
+	d.SetId(zoneID)
+	d.Set("names", names)
+	if err := d.Set("resource_record_sets", flattenResourceRecordSets(recordSets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_sets: %s", err)
+	}
+
+	return diags
+}
+
+func findResourceRecordSets(ctx context.Context, conn *route53.Route53, input *route53.ListResourceRecordSetsInput, filter tfslices.Predicate[*route53.ResourceRecordSet]) ([]*route53.ResourceRecordSet, error) {
+	var output []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// flattenRecordName returns a record name in the form used by the aws_route53_record resource.
+func flattenRecordName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(CleanRecordName(name), "."))
+}
+
+func flattenResourceRecordSets(apiObjects []*route53.ResourceRecordSet) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		recordType := aws.StringValue(apiObject.Type)
+		tfMap := map[string]interface{}{
+			"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+			"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+			"name":                             flattenRecordName(aws.StringValue(apiObject.Name)),
+			"records":                          FlattenResourceRecords(apiObject.ResourceRecords, recordType),
+			"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+			"ttl":                              aws.Int64Value(apiObject.TTL),
+			"type":                             recordType,
+		}
+
+		if v := apiObject.AliasTarget; v != nil {
+			tfMap["alias"] = []interface{}{flattenAliasTarget(v)}
+		}
+
+		if v := apiObject.CidrRoutingConfig; v != nil {
+			tfMap["cidr_routing_policy"] = []interface{}{flattenCIDRRoutingConfig(v)}
+		}
+
+		if v := apiObject.Failover; v != nil {
+			tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+				"type": aws.StringValue(v),
+			}}
+		}
+
+		if v := apiObject.GeoLocation; v != nil {
+			tfMap["geolocation_routing_policy"] = []interface{}{flattenGeoLocation(v)}
+		}
+
+		if v := apiObject.Region; v != nil {
+			tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+				"region": aws.StringValue(v),
+			}}
+		}
+
+		if v := apiObject.Weight; v != nil {
+			tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+				"weight": aws.Int64Value(v),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
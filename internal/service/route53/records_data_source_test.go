@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+	dataSourceName := "data.aws_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_nameRegexAndType(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"name":                             zoneName.Subdomain("www").String(),
+						"type":                             "A",
+						"ttl":                              "300",
+						"set_identifier":                   "dev",
+						"records.#":                        "1",
+						"records.0":                        "127.0.0.1",
+						"weighted_routing_policy.#":        "1",
+						"weighted_routing_policy.0.weight": "10",
+						"multivalue_answer_routing_policy": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"set_identifier":                   "live",
+						"records.0":                        "127.0.0.2",
+						"weighted_routing_policy.0.weight": "90",
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsDataSourceConfig_setIdentifier(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", zoneName.Subdomain("www").String()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.set_identifier", "live"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "dev" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "A"
+  ttl            = 300
+  set_identifier = "dev"
+  records        = ["127.0.0.1"]
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+resource "aws_route53_record" "live" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "A"
+  ttl            = 300
+  set_identifier = "live"
+  records        = ["127.0.0.2"]
+
+  weighted_routing_policy {
+    weight = 90
+  }
+}
+
+resource "aws_route53_record" "txt" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "TXT"
+  ttl     = 300
+  records = ["test"]
+}
+`, zoneName)
+}
+
+func testAccRecordsDataSourceConfig_nameRegexAndType(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id    = aws_route53_zone.test.zone_id
+  name_regex = "^www\\."
+  type       = "A"
+
+  depends_on = [aws_route53_record.dev, aws_route53_record.live, aws_route53_record.txt]
+}
+`)
+}
+
+func testAccRecordsDataSourceConfig_setIdentifier(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  set_identifier = "live"
+
+  depends_on = [aws_route53_record.dev, aws_route53_record.live, aws_route53_record.txt]
+}
+`)
+}
@@ -0,0 +1,699 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5" // nosemgrep:ci.avoid-md5 // S3 ETags of single-part uploads are MD5 digests.
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDirectorySyncPattern,
+				},
+			},
+			"file_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"file_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateMetadataIsLowerCase,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDirectorySyncPattern,
+						},
+					},
+				},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([^/].*/)?$`), "must not start with '/' and must end with '/'"),
+			},
+			"manifest_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, keyPrefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+	id := directorySyncCreateResourceID(bucket, keyPrefix)
+
+	if err := directorySync(ctx, d, meta, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only the bucket is checked. The objects are reconciled against the
+	// manifest on every create and update.
+	bucket := d.Get("bucket").(string)
+	err := findBucket(ctx, directorySyncConn(ctx, meta, bucket), bucket)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Uploading all files is only needed when object settings may have changed.
+	// Otherwise objects whose content matches the local file are skipped.
+	uploadAll := d.HasChange("file_rule")
+
+	if err := directorySync(ctx, d, meta, uploadAll); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, keyPrefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+	conn := directorySyncConn(ctx, meta, bucket)
+
+	var keys []string
+	if d.Get("delete_orphans").(bool) {
+		// The resource owns the whole key prefix.
+		objects, err := findDirectorySyncObjects(ctx, conn, bucket, keyPrefix)
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+		}
+
+		for key := range objects {
+			keys = append(keys, key)
+		}
+	} else {
+		// The objects to delete are those of the files in the local directory.
+		files, _, err := buildDirectorySyncManifest(d.Get("source_dir").(string), keyPrefix, flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)), expandDirectorySyncFileRules(d.Get("file_rule").([]interface{})))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): determining objects to delete from source_dir: %s", d.Id(), err)
+		}
+
+		keys = tfslices.ApplyToAll(files, func(v directorySyncFile) string {
+			return v.Key
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s) objects: %d", d.Id(), len(keys))
+	if err := deleteDirectorySyncObjects(ctx, conn, bucket, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// With delete_orphans the resource owns every object under the key prefix.
+	if d.NewValueKnown("key_prefix") && d.Get("delete_orphans").(bool) && d.Get("key_prefix").(string) == "" {
+		return errors.New(`"key_prefix" must be set when "delete_orphans" is true`)
+	}
+
+	for _, key := range []string{"excludes", "file_rule", "key_prefix", "source_dir"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("file_count"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("manifest_digest")
+		}
+	}
+
+	files, digest, err := buildDirectorySyncManifest(d.Get("source_dir").(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)), expandDirectorySyncFileRules(d.Get("file_rule").([]interface{})))
+
+	if err != nil {
+		return err
+	}
+
+	if digest != d.Get("manifest_digest").(string) {
+		if err := d.SetNew("file_count", len(files)); err != nil {
+			return err
+		}
+		return d.SetNew("manifest_digest", digest)
+	}
+
+	return nil
+}
+
+const (
+	directorySyncResourceIDSeparator = "/"
+
+	// directorySyncSHA256MetadataKey is the user-defined object metadata key that stores the SHA256 digest of the uploaded file.
+	// Unlike the ETag, it identifies the content of multipart uploads and of objects encrypted with SSE-KMS.
+	directorySyncSHA256MetadataKey = "content-sha256"
+)
+
+func directorySyncCreateResourceID(bucket, keyPrefix string) string {
+	return bucket + directorySyncResourceIDSeparator + keyPrefix
+}
+
+func directorySyncConn(ctx context.Context, meta interface{}, bucket string) *s3.Client {
+	if isDirectoryBucket(bucket) {
+		return meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	return meta.(*conns.AWSClient).S3Client(ctx)
+}
+
+// directorySync uploads the local directory to the bucket and, if configured, deletes orphaned objects.
+func directorySync(ctx context.Context, d *schema.ResourceData, meta interface{}, uploadAll bool) error {
+	bucket, keyPrefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+	conn := directorySyncConn(ctx, meta, bucket)
+
+	files, digest, err := buildDirectorySyncManifest(d.Get("source_dir").(string), keyPrefix, flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)), expandDirectorySyncFileRules(d.Get("file_rule").([]interface{})))
+
+	if err != nil {
+		return err
+	}
+
+	objects, err := findDirectorySyncObjects(ctx, conn, bucket, keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	var existing map[string]string
+	if !uploadAll {
+		existing = objects
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Directory Sync objects: %d", len(files))
+	if err := uploadDirectorySyncFiles(ctx, conn, bucket, files, existing, d.Get("concurrency").(int)); err != nil {
+		return err
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		keys := make(map[string]struct{}, len(files))
+		for _, file := range files {
+			keys[file.Key] = struct{}{}
+		}
+
+		var orphans []string
+		for key := range objects {
+			if _, ok := keys[key]; !ok {
+				orphans = append(orphans, key)
+			}
+		}
+
+		log.Printf("[DEBUG] Deleting S3 Directory Sync orphaned objects: %d", len(orphans))
+		if err := deleteDirectorySyncObjects(ctx, conn, bucket, orphans); err != nil {
+			return err
+		}
+	}
+
+	d.Set("file_count", len(files))
+	d.Set("manifest_digest", digest)
+
+	return nil
+}
+
+// uploadDirectorySyncFiles uploads the files, skipping those whose content matches the existing object.
+// existing maps the keys of existing objects to their ETags.
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.Client, bucket string, files []directorySyncFile, existing map[string]string, concurrency int) error {
+	// The transfer manager splits large files into concurrently uploaded parts.
+	uploader := manager.NewUploader(conn)
+
+	var (
+		errs []error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, file := range files {
+		file := file
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if etag, ok := existing[file.Key]; ok {
+				unchanged, err := directorySyncObjectUnchanged(ctx, conn, bucket, file, etag)
+
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+					return
+				}
+
+				if unchanged {
+					return
+				}
+			}
+
+			if err := uploadDirectorySyncFile(ctx, uploader, bucket, file); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// directorySyncObjectUnchanged reports whether the existing object has the content of the local file.
+func directorySyncObjectUnchanged(ctx context.Context, conn *s3.Client, bucket string, file directorySyncFile, etag string) (bool, error) {
+	// The ETag of an object uploaded in a single part without SSE-KMS is the MD5 digest of its content.
+	if strings.Trim(etag, `"`) == file.MD5 {
+		return true, nil
+	}
+
+	output, err := findObjectByBucketAndKey(ctx, conn, bucket, file.Key, "", "")
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("reading S3 Object (%s) in Bucket (%s): %w", file.Key, bucket, err)
+	}
+
+	return output.Metadata[directorySyncSHA256MetadataKey] == file.SHA256, nil
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *manager.Uploader, bucket string, file directorySyncFile) error {
+	f, err := os.Open(file.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	metadata := make(map[string]string, len(file.Metadata)+1)
+	for k, v := range file.Metadata {
+		metadata[k] = v
+	}
+	metadata[directorySyncSHA256MetadataKey] = file.SHA256
+
+	input := &s3.PutObjectInput{
+		Body:        f,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(file.ContentType),
+		Key:         aws.String(file.Key),
+		Metadata:    metadata,
+	}
+
+	if file.CacheControl != "" {
+		input.CacheControl = aws.String(file.CacheControl)
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", file.Key, bucket, err)
+	}
+
+	return nil
+}
+
+// findDirectorySyncObjects returns the ETags of all objects under the key prefix, keyed by object key.
+func findDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range page.Contents {
+			output[aws.ToString(v.Key)] = aws.ToString(v.ETag)
+		}
+	}
+
+	return output, nil
+}
+
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	var errs []error
+
+	// DeleteObjects accepts at most 1000 keys per request.
+	for _, chunk := range tfslices.Chunks(keys, 1000) {
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{
+				Objects: tfslices.ApplyToAll(chunk, func(v string) types.ObjectIdentifier {
+					return types.ObjectIdentifier{
+						Key: aws.String(v),
+					}
+				}),
+				Quiet: aws.Bool(true), // Only report errors.
+			},
+		}
+
+		output, err := conn.DeleteObjects(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range output.Errors {
+			errs = append(errs, newDeleteObjectVersionError(v))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	return nil
+}
+
+type directorySyncFileRule struct {
+	CacheControl string
+	ContentType  string
+	Metadata     map[string]string
+	Pattern      string
+}
+
+func expandDirectorySyncFileRules(tfList []interface{}) []directorySyncFileRule {
+	var apiObjects []directorySyncFileRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := directorySyncFileRule{
+			CacheControl: tfMap["cache_control"].(string),
+			ContentType:  tfMap["content_type"].(string),
+			Pattern:      tfMap["pattern"].(string),
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Metadata = flex.ExpandStringValueMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+type directorySyncFile struct {
+	CacheControl string
+	ContentType  string
+	Key          string
+	MD5          string
+	Metadata     map[string]string
+	Path         string
+	SHA256       string
+}
+
+// buildDirectorySyncManifest returns the files to upload from the local directory, sorted by object key,
+// and a digest of their keys, contents and object settings.
+// File rules are applied in order, later rules overriding earlier ones for each setting they specify.
+func buildDirectorySyncManifest(dir, keyPrefix string, excludes []string, rules []directorySyncFileRule) ([]directorySyncFile, string, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var files []directorySyncFile
+	err = filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range excludes {
+			if directorySyncPatternMatch(pattern, rel) {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		file, err := newDirectorySyncFile(p, rel, keyPrefix, rules)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, file)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Key < files[j].Key
+	})
+
+	h := sha256.New()
+	for _, file := range files {
+		var metadata []string
+		for k, v := range file.Metadata {
+			metadata = append(metadata, k+"="+v)
+		}
+		sort.Strings(metadata)
+
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s\n", file.Key, file.SHA256, file.ContentType, file.CacheControl, strings.Join(metadata, "\x00"))
+	}
+
+	return files, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func newDirectorySyncFile(filename, rel, keyPrefix string, rules []directorySyncFileRule) (directorySyncFile, error) {
+	file := directorySyncFile{
+		Key:  keyPrefix + rel,
+		Path: filename,
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return file, err
+	}
+	defer f.Close()
+
+	md5Hash, sha256Hash := md5.New(), sha256.New() // nosemgrep:ci.avoid-md5
+	w := io.MultiWriter(md5Hash, sha256Hash)
+
+	// The first 512 bytes are used for content sniffing.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return file, err
+	}
+	head = head[:n]
+
+	if _, err := w.Write(head); err != nil {
+		return file, err
+	}
+
+	if _, err := io.Copy(w, f); err != nil {
+		return file, err
+	}
+
+	file.MD5 = hexSum(md5Hash)
+	file.SHA256 = hexSum(sha256Hash)
+
+	file.ContentType = mime.TypeByExtension(path.Ext(rel))
+	if file.ContentType == "" {
+		file.ContentType = http.DetectContentType(head)
+	}
+
+	for _, rule := range rules {
+		if !directorySyncPatternMatch(rule.Pattern, rel) {
+			continue
+		}
+
+		if rule.CacheControl != "" {
+			file.CacheControl = rule.CacheControl
+		}
+
+		if rule.ContentType != "" {
+			file.ContentType = rule.ContentType
+		}
+
+		for k, v := range rule.Metadata {
+			if file.Metadata == nil {
+				file.Metadata = make(map[string]string)
+			}
+			file.Metadata[k] = v
+		}
+	}
+
+	return file, nil
+}
+
+func hexSum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// directorySyncPatternMatch reports whether a slash-separated relative path matches a pattern.
+// Patterns without a slash are matched against the base name, others against the full path.
+func directorySyncPatternMatch(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(name)
+	}
+
+	ok, _ := path.Match(pattern, name)
+
+	return ok
+}
+
+func validateDirectorySyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, v, err))
+	}
+
+	return
+}
@@ -0,0 +1,433 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectorySyncPatternMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "*.html", name: "index.html", want: true},
+		{pattern: "*.html", name: "docs/index.html", want: true},
+		{pattern: "*.html", name: "index.htm", want: false},
+		{pattern: "docs/*.html", name: "docs/index.html", want: true},
+		{pattern: "docs/*.html", name: "index.html", want: false},
+		{pattern: "docs/*.html", name: "docs/api/index.html", want: false},
+		{pattern: ".git", name: ".git", want: true},
+		{pattern: "assets/*", name: "assets/app.js", want: true},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfs3.DirectorySyncPatternMatch(testCase.pattern, testCase.name); got != testCase.want {
+			t.Errorf("DirectorySyncPatternMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, testCase.want)
+		}
+	}
+}
+
+func TestBuildDirectorySyncManifest(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	testAccWriteDirectorySyncFiles(t, dir, map[string]string{
+		"index.html":       "<html></html>",
+		"css/site.css":     "body {}",
+		"data":             "\x00\x01\x02",
+		".git/config":      "[core]",
+		"drafts/page.html": "<html></html>",
+	})
+
+	files, digest, err := tfs3.BuildDirectorySyncManifest(dir, "site/", []string{".git", "drafts"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got [][2]string
+	for _, file := range files {
+		got = append(got, [2]string{file.Key, file.ContentType})
+	}
+	want := [][2]string{
+		{"site/css/site.css", "text/css; charset=utf-8"},
+		{"site/data", "application/octet-stream"},
+		{"site/index.html", "text/html; charset=utf-8"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected files (+want, -got): %s", diff)
+	}
+
+	// The digest doesn't depend on file modification times.
+	mtime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	if err := os.Chtimes(filepath.Join(dir, "index.html"), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	_, digestAgain, err := tfs3.BuildDirectorySyncManifest(dir, "site/", []string{".git", "drafts"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digestAgain != digest {
+		t.Errorf("digest = %q, want %q", digestAgain, digest)
+	}
+
+	testAccWriteDirectorySyncFiles(t, dir, map[string]string{
+		"index.html": "<html><body></body></html>",
+	})
+
+	_, digestChanged, err := tfs3.BuildDirectorySyncManifest(dir, "site/", []string{".git", "drafts"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digestChanged == digest {
+		t.Error("expected digest to change with file content")
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccWriteDirectorySyncFiles(t, sourceDir, map[string]string{
+						"index.html":   "<html></html>",
+						"css/site.css": "body {}",
+						"data.json":    "{}",
+					})
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, "aws_s3_bucket.test", "site/", []string{"site/css/site.css", "site/data.json", "site/index.html"}),
+					testAccCheckDirectorySyncObject(ctx, "aws_s3_bucket.test", "site/index.html", func(v *s3.HeadObjectOutput) error {
+						if got, want := aws.ToString(v.ContentType), "text/html; charset=utf-8"; got != want {
+							return fmt.Errorf("ContentType = %q, want %q", got, want)
+						}
+						// SHA256 of "<html></html>".
+						if got, want := v.Metadata["content-sha256"], "b633a587c652d02386c4f16f8c6f6aab7352d97f16367c3c40576214372dd628"; got != want {
+							return fmt.Errorf("Metadata[content-sha256] = %q, want %q", got, want)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", "false"),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_digest"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteDirectorySyncFiles(t, sourceDir, map[string]string{
+						"about.html": "<html></html>",
+					})
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, "aws_s3_bucket.test", "site/", []string{"site/about.html", "site/css/site.css", "site/data.json", "site/index.html"}),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_fileRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccWriteDirectorySyncFiles(t, sourceDir, map[string]string{
+						"index.html":      "<html></html>",
+						"assets/app.js":   "console.log('ok');",
+						"assets/data.bin": "\x00\x01\x02",
+					})
+				},
+				Config: testAccDirectorySyncConfig_fileRules(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObject(ctx, "aws_s3_bucket.test", "index.html", func(v *s3.HeadObjectOutput) error {
+						if got, want := aws.ToString(v.CacheControl), "no-cache"; got != want {
+							return fmt.Errorf("CacheControl = %q, want %q", got, want)
+						}
+						return nil
+					}),
+					testAccCheckDirectorySyncObject(ctx, "aws_s3_bucket.test", "assets/app.js", func(v *s3.HeadObjectOutput) error {
+						if got, want := aws.ToString(v.CacheControl), "public, max-age=31536000, immutable"; got != want {
+							return fmt.Errorf("CacheControl = %q, want %q", got, want)
+						}
+						if got, want := v.Metadata["tier"], "static"; got != want {
+							return fmt.Errorf("Metadata[tier] = %q, want %q", got, want)
+						}
+						return nil
+					}),
+					testAccCheckDirectorySyncObject(ctx, "aws_s3_bucket.test", "assets/data.bin", func(v *s3.HeadObjectOutput) error {
+						if got, want := aws.ToString(v.ContentType), "application/x-custom"; got != want {
+							return fmt.Errorf("ContentType = %q, want %q", got, want)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "file_rule.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteOrphans(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccWriteDirectorySyncFiles(t, sourceDir, map[string]string{
+						"index.html": "<html></html>",
+						"old.html":   "<html></html>",
+					})
+				},
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, "aws_s3_bucket.test", "", []string{"site-old/keep.html", "site/index.html", "site/old.html"}),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", "true"),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(sourceDir, "old.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, "aws_s3_bucket.test", "", []string{"site-old/keep.html", "site/index.html"}),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "1"),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_deleteOrphansDestroyed(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, "aws_s3_bucket.test", "", []string{"site-old/keep.html"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_keyPrefixValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectorySyncConfig_keyPrefix(rName, sourceDir, "site", false),
+				ExpectError: regexache.MustCompile(`must not start with '/' and must end with '/'`),
+			},
+			{
+				Config:      testAccDirectorySyncConfig_keyPrefix(rName, sourceDir, "", true),
+				ExpectError: regexache.MustCompile(`"key_prefix" must be set when "delete_orphans" is true`),
+			},
+		},
+	})
+}
+
+func testAccWriteDirectorySyncFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckDirectorySyncObjects(ctx context.Context, n, keyPrefix string, want []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		objects, err := tfs3.FindDirectorySyncObjects(ctx, conn, rs.Primary.ID, keyPrefix)
+
+		if err != nil {
+			return err
+		}
+
+		got := make([]string, 0, len(objects))
+		for key := range objects {
+			got = append(got, key)
+		}
+
+		if diff := cmp.Diff(got, want, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+			return fmt.Errorf("unexpected objects (+want, -got): %s", diff)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObject(ctx context.Context, n, key string, check func(*s3.HeadObjectOutput) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.ID, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		return check(output)
+	}
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncConfig_fileRules(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+
+  file_rule {
+    pattern       = "*.html"
+    cache_control = "no-cache"
+  }
+
+  file_rule {
+    pattern       = "assets/*"
+    cache_control = "public, max-age=31536000, immutable"
+
+    metadata = {
+      tier = "static"
+    }
+  }
+
+  file_rule {
+    pattern      = "*.bin"
+    content_type = "application/x-custom"
+  }
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncConfig_deleteOrphansBase(rName string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), `
+# Shares the key prefix's characters but not the key prefix.
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "site-old/keep.html"
+  content = "<html></html>"
+}
+`)
+}
+
+func testAccDirectorySyncConfig_deleteOrphans(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_deleteOrphansBase(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  delete_orphans = true
+  key_prefix     = "site/"
+  source_dir     = %[1]q
+
+  depends_on = [aws_s3_object.test]
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncConfig_deleteOrphansDestroyed(rName string) string {
+	return testAccDirectorySyncConfig_deleteOrphansBase(rName)
+}
+
+func testAccDirectorySyncConfig_keyPrefix(rName, sourceDir, keyPrefix string, deleteOrphans bool) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  delete_orphans = %[3]t
+  key_prefix     = %[2]q
+  source_dir     = %[1]q
+}
+`, sourceDir, keyPrefix, deleteOrphans))
+}
@@ -9,7 +9,9 @@ var (
 
 	BucketRegionalDomainName              = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
+	BuildDirectorySyncManifest            = buildDirectorySyncManifest
 	DeleteAllObjectVersions               = deleteAllObjectVersions
+	DirectorySyncPatternMatch             = directorySyncPatternMatch
 	EmptyBucket                           = emptyBucket
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
@@ -20,6 +22,7 @@ var (
 	FindBucketRequestPayment              = findBucketRequestPayment
 	FindBucketVersioning                  = findBucketVersioning
 	FindBucketWebsite                     = findBucketWebsite
+	FindDirectorySyncObjects              = findDirectorySyncObjects
 	FindCORSRules                         = findCORSRules
 	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
 	FindInventoryConfiguration            = findInventoryConfiguration
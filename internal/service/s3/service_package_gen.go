@@ -152,6 +152,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceBucketWebsiteConfiguration,
 			TypeName: "aws_s3_bucket_website_configuration",
 		},
+		{
+			Factory:  ResourceDirectorySync,
+			TypeName: "aws_s3_directory_sync",
+			Name:     "Directory Sync",
+		},
 		{
 			Factory:  ResourceObject,
 			TypeName: "aws_s3_object",
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomationRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"type":                                      string(types.AutomationRulesActionTypeFindingFieldsUpdate),
+						"finding_fields_update.#":                   "1",
+						"finding_fields_update.0.severity.#":        "1",
+						"finding_fields_update.0.severity.0.label":  string(types.SeverityLabelLow),
+						"finding_fields_update.0.workflow.#":        "1",
+						"finding_fields_update.0.workflow.0.status": string(types.WorkflowStatusSuppressed),
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.product_name.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "criteria.0.product_name.*", map[string]string{
+						"comparison": string(types.StringFilterComparisonEquals),
+						"value":      "Security Hub",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", string(types.RuleStatusEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceAutomationRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", string(types.RuleStatusEnabled)),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"finding_fields_update.0.confidence":              "20",
+						"finding_fields_update.0.criticality":             "25",
+						"finding_fields_update.0.note.0.text":             "example note",
+						"finding_fields_update.0.note.0.updated_by":       "sechub-automation",
+						"finding_fields_update.0.types.#":                 "1",
+						"finding_fields_update.0.types.0":                 "Software and Configuration Checks/Industry and Regulatory Standards",
+						"finding_fields_update.0.user_defined_fields.%":   "1",
+						"finding_fields_update.0.user_defined_fields.key": "value",
+						"finding_fields_update.0.verification_state":      string(types.VerificationStateBenignPositive),
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.product_name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.confidence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.updated_at.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description updated"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", string(types.RuleStatusDisabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_automation_rule" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Automation Rule (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRuleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		_, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAutomationRuleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  action {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+  }
+
+  criteria {
+    product_name {
+      comparison = "EQUALS"
+      value      = "Security Hub"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName)
+}
+
+func testAccAutomationRuleConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description updated"
+  is_terminal = true
+  rule_name   = %[1]q
+  rule_order  = 2
+  rule_status = "DISABLED"
+
+  action {
+    finding_fields_update {
+      confidence  = 20
+      criticality = 25
+
+      note {
+        text       = "example note"
+        updated_by = "sechub-automation"
+      }
+
+      types = ["Software and Configuration Checks/Industry and Regulatory Standards"]
+
+      user_defined_fields = {
+        key = "value"
+      }
+
+      verification_state = "BENIGN_POSITIVE"
+    }
+  }
+
+  criteria {
+    product_name {
+      comparison = "EQUALS"
+      value      = "Security Hub"
+    }
+
+    confidence {
+      gte = "40"
+    }
+
+    updated_at {
+      date_range {
+        unit  = "DAYS"
+        value = 5
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName)
+}
+
+func testAccAutomationRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  action {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+  }
+
+  criteria {
+    product_name {
+      comparison = "EQUALS"
+      value      = "Security Hub"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAutomationRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  action {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+  }
+
+  criteria {
+    product_name {
+      comparison = "EQUALS"
+      value      = "Security Hub"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
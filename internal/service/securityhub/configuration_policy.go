@@ -0,0 +1,548 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy", name="Configuration Policy")
+func ResourceConfigurationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled_standard_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"security_controls_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers"},
+									},
+									"enabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers"},
+									},
+									"security_control_custom_parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"parameter": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     securityControlParameterSchema(),
+												},
+												"security_control_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func securityControlParameterSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bool": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"double": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+			"enum": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"enum_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"int": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"int_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"string": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"string_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"value_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.ParameterValueType](),
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &securityhub.CreateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicyMemberSecurityHub(d.Get("configuration_policy").([]interface{})),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfigurationPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Configuration Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	output, err := FindConfigurationPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("configuration_policy", flattenPolicyMemberSecurityHub(output.ConfigurationPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration_policy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceConfigurationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.UpdateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicyMemberSecurityHub(d.Get("configuration_policy").([]interface{})),
+		Description:         aws.String(d.Get("description").(string)),
+		Identifier:          aws.String(d.Id()),
+		Name:                aws.String(d.Get("name").(string)),
+	}
+
+	_, err := conn.UpdateConfigurationPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationPolicy(ctx, &securityhub.DeleteConfigurationPolicyInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConfigurationPolicyByID(ctx context.Context, conn *securityhub.Client, id string) (*securityhub.GetConfigurationPolicyOutput, error) {
+	input := &securityhub.GetConfigurationPolicyInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfigurationPolicy(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPolicyMemberSecurityHub(tfList []interface{}) types.Policy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.PolicyMemberSecurityHub{}
+
+	if v, ok := tfMap["enabled_standard_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Value.EnabledStandardIdentifiers = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["security_controls_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Value.SecurityControlsConfiguration = expandSecurityControlsConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["service_enabled"].(bool); ok {
+		apiObject.Value.ServiceEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSecurityControlsConfiguration(tfMap map[string]interface{}) *types.SecurityControlsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SecurityControlsConfiguration{}
+
+	// Exactly one of the enabled or disabled lists must be sent. An empty disabled list enables all controls.
+	if v, ok := tfMap["enabled_control_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledSecurityControlIdentifiers = flex.ExpandStringValueSet(v)
+	} else if v, ok := tfMap["disabled_control_identifiers"].(*schema.Set); ok {
+		apiObject.DisabledSecurityControlIdentifiers = flex.ExpandStringValueSet(v)
+		if apiObject.DisabledSecurityControlIdentifiers == nil {
+			apiObject.DisabledSecurityControlIdentifiers = []string{}
+		}
+	}
+
+	if v, ok := tfMap["security_control_custom_parameter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityControlCustomParameters = expandSecurityControlCustomParameters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandSecurityControlCustomParameters(tfList []interface{}) []types.SecurityControlCustomParameter {
+	var apiObjects []types.SecurityControlCustomParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.SecurityControlCustomParameter{
+			Parameters:        map[string]types.ParameterConfiguration{},
+			SecurityControlId: aws.String(tfMap["security_control_id"].(string)),
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				apiObject.Parameters[tfMap["name"].(string)] = expandParameterConfiguration(tfMap)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandParameterConfiguration(tfMap map[string]interface{}) types.ParameterConfiguration {
+	apiObject := types.ParameterConfiguration{
+		ValueType: types.ParameterValueType(tfMap["value_type"].(string)),
+	}
+
+	value := func(k string) (interface{}, bool) {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			return v[0].(map[string]interface{})["value"], true
+		}
+		return nil, false
+	}
+
+	if v, ok := value("bool"); ok {
+		apiObject.Value = &types.ParameterValueMemberBoolean{Value: v.(bool)}
+	} else if v, ok := value("double"); ok {
+		apiObject.Value = &types.ParameterValueMemberDouble{Value: v.(float64)}
+	} else if v, ok := value("enum"); ok {
+		apiObject.Value = &types.ParameterValueMemberEnum{Value: v.(string)}
+	} else if v, ok := value("enum_list"); ok {
+		apiObject.Value = &types.ParameterValueMemberEnumList{Value: flex.ExpandStringValueList(v.([]interface{}))}
+	} else if v, ok := value("int"); ok {
+		apiObject.Value = &types.ParameterValueMemberInteger{Value: int32(v.(int))}
+	} else if v, ok := value("int_list"); ok {
+		var values []int32
+		for _, v := range v.([]interface{}) {
+			values = append(values, int32(v.(int)))
+		}
+		apiObject.Value = &types.ParameterValueMemberIntegerList{Value: values}
+	} else if v, ok := value("string"); ok {
+		apiObject.Value = &types.ParameterValueMemberString{Value: v.(string)}
+	} else if v, ok := value("string_list"); ok {
+		apiObject.Value = &types.ParameterValueMemberStringList{Value: flex.ExpandStringValueList(v.([]interface{}))}
+	}
+
+	return apiObject
+}
+
+func flattenPolicyMemberSecurityHub(apiObject types.Policy) []interface{} {
+	v, ok := apiObject.(*types.PolicyMemberSecurityHub)
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled_standard_arns": v.Value.EnabledStandardIdentifiers,
+		"service_enabled":       aws.ToBool(v.Value.ServiceEnabled),
+	}
+
+	if v := v.Value.SecurityControlsConfiguration; v != nil {
+		tfMap["security_controls_configuration"] = []interface{}{flattenSecurityControlsConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityControlsConfiguration(apiObject *types.SecurityControlsConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"disabled_control_identifiers": apiObject.DisabledSecurityControlIdentifiers,
+		"enabled_control_identifiers":  apiObject.EnabledSecurityControlIdentifiers,
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.SecurityControlCustomParameters {
+		var parameters []interface{}
+
+		for name, apiObject := range apiObject.Parameters {
+			parameters = append(parameters, flattenParameterConfiguration(name, apiObject))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter":           parameters,
+			"security_control_id": aws.ToString(apiObject.SecurityControlId),
+		})
+	}
+
+	tfMap["security_control_custom_parameter"] = tfList
+
+	return tfMap
+}
+
+func flattenParameterConfiguration(name string, apiObject types.ParameterConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":       name,
+		"value_type": string(apiObject.ValueType),
+	}
+
+	value := func(v interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"value": v}}
+	}
+
+	switch v := apiObject.Value.(type) {
+	case *types.ParameterValueMemberBoolean:
+		tfMap["bool"] = value(v.Value)
+	case *types.ParameterValueMemberDouble:
+		tfMap["double"] = value(v.Value)
+	case *types.ParameterValueMemberEnum:
+		tfMap["enum"] = value(v.Value)
+	case *types.ParameterValueMemberEnumList:
+		tfMap["enum_list"] = value(v.Value)
+	case *types.ParameterValueMemberInteger:
+		tfMap["int"] = value(int(v.Value))
+	case *types.ParameterValueMemberIntegerList:
+		var values []interface{}
+		for _, v := range v.Value {
+			values = append(values, int(v))
+		}
+		tfMap["int_list"] = value(values)
+	case *types.ParameterValueMemberString:
+		tfMap["string"] = value(v.Value)
+	case *types.ParameterValueMemberStringList:
+		tfMap["string_list"] = value(v.Value)
+	}
+
+	return tfMap
+}
@@ -5,7 +5,9 @@ package securityhub
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
@@ -32,6 +34,11 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"auto_enable": {
 				Type:     schema.TypeBool,
@@ -43,6 +50,21 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.AutoEnableStandards](),
 			},
+			"organization_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.OrganizationConfigurationConfigurationType](),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -59,6 +81,10 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		input.AutoEnableStandards = types.AutoEnableStandards(v.(string))
 	}
 
+	if v, ok := d.GetOk("organization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OrganizationConfiguration = expandOrganizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.UpdateOrganizationConfiguration(ctx, input)
 
 	if err != nil {
@@ -69,6 +95,17 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		d.SetId(meta.(*conns.AWSClient).AccountID)
 	}
 
+	if input.OrganizationConfiguration != nil {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
+		if _, err := waitOrganizationConfigurationEnabled(ctx, conn, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -90,6 +127,9 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	d.Set("auto_enable", output.AutoEnable)
 	d.Set("auto_enable_standards", output.AutoEnableStandards)
+	if err := d.Set("organization_configuration", flattenOrganizationConfiguration(output.OrganizationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting organization_configuration: %s", err)
+	}
 
 	return diags
 }
@@ -116,3 +156,70 @@ func FindOrganizationConfiguration(ctx context.Context, conn *securityhub.Client
 
 	return output, nil
 }
+
+func statusOrganizationConfiguration(ctx context.Context, conn *securityhub.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.OrganizationConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output, string(output.OrganizationConfiguration.Status), nil
+	}
+}
+
+func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub.Client, timeout time.Duration) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.OrganizationConfigurationStatusPending),
+		Target:  enum.Slice(types.OrganizationConfigurationStatusEnabled),
+		Refresh: statusOrganizationConfiguration(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.DescribeOrganizationConfigurationOutput); ok {
+		if v := output.OrganizationConfiguration; v != nil && v.StatusMessage != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOrganizationConfiguration(tfMap map[string]interface{}) *types.OrganizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.OrganizationConfiguration{}
+
+	if v, ok := tfMap["configuration_type"].(string); ok && v != "" {
+		apiObject.ConfigurationType = types.OrganizationConfigurationConfigurationType(v)
+	}
+
+	return apiObject
+}
+
+func flattenOrganizationConfiguration(apiObject *types.OrganizationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"configuration_type": apiObject.ConfigurationType,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Log Source")
+func newCustomLogSourceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &customLogSourceResource{}
+
+	return r, nil
+}
+
+const (
+	ResNameCustomLogSource = "Custom Log Source"
+)
+
+type customLogSourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *customLogSourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_securitylake_custom_log_source"
+}
+
+func (r *customLogSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceAttributesModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"crawler_arn":  fwtypes.ARNType,
+						"database_arn": fwtypes.ARNType,
+						"table_arn":    fwtypes.ARNType,
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_classes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"provider_details": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceProviderModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"location": types.StringType,
+						"role_arn": fwtypes.ARNType,
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 20),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"crawler_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceCrawlerConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+						"provider_identity": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[awsIdentityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"external_id": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"principal": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *customLogSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data customLogSourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateCustomLogSourceInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateCustomLogSource(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionCreating, ResNameCustomLogSource, data.SourceName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	customLogSource := output.Source
+	data.ID = flex.StringToFramework(ctx, customLogSource.SourceName)
+
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, customLogSource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *customLogSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data customLogSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customLogSource, err := findCustomLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameCustomLogSource, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The configuration and event classes are not returned by the API.
+	data.SourceName = flex.StringToFramework(ctx, customLogSource.SourceName)
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, customLogSource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *customLogSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// NoOP.
+}
+
+func (r *customLogSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data customLogSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.DeleteCustomLogSourceInput{
+		SourceName:    flex.StringFromFramework(ctx, data.ID),
+		SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+	}
+
+	_, err := conn.DeleteCustomLogSource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionDeleting, ResNameCustomLogSource, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{}
+	var output *awstypes.CustomLogSourceResource
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Sources {
+			for _, v := range v.Sources {
+				if v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource); ok {
+					if v := v.Value; aws.ToString(v.SourceName) == sourceName {
+						output = &v
+						break
+					}
+				}
+			}
+		}
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(sourceName)
+	}
+
+	return output, nil
+}
+
+type customLogSourceResourceModel struct {
+	Attributes      fwtypes.ListNestedObjectValueOf[customLogSourceAttributesModel]    `tfsdk:"attributes"`
+	Configuration   fwtypes.ListNestedObjectValueOf[customLogSourceConfigurationModel] `tfsdk:"configuration"`
+	EventClasses    fwtypes.SetValueOf[types.String]                                   `tfsdk:"event_classes"`
+	ID              types.String                                                       `tfsdk:"id"`
+	ProviderDetails fwtypes.ListNestedObjectValueOf[customLogSourceProviderModel]      `tfsdk:"provider_details"`
+	SourceName      types.String                                                       `tfsdk:"source_name"`
+	SourceVersion   types.String                                                       `tfsdk:"source_version"`
+}
+
+func (data *customLogSourceResourceModel) refreshFromOutput(ctx context.Context, apiObject *awstypes.CustomLogSourceResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var attributes customLogSourceAttributesModel
+	diags.Append(flex.Flatten(ctx, apiObject.Attributes, &attributes)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var providerDetails customLogSourceProviderModel
+	diags.Append(flex.Flatten(ctx, apiObject.Provider, &providerDetails)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Attributes = fwtypes.NewListNestedObjectValueOfPtr(ctx, &attributes)
+	data.ProviderDetails = fwtypes.NewListNestedObjectValueOfPtr(ctx, &providerDetails)
+	data.SourceVersion = flex.StringToFramework(ctx, apiObject.SourceVersion)
+
+	return diags
+}
+
+type customLogSourceAttributesModel struct {
+	CrawlerARN  fwtypes.ARN `tfsdk:"crawler_arn"`
+	DatabaseARN fwtypes.ARN `tfsdk:"database_arn"`
+	TableARN    fwtypes.ARN `tfsdk:"table_arn"`
+}
+
+type customLogSourceConfigurationModel struct {
+	CrawlerConfiguration fwtypes.ListNestedObjectValueOf[customLogSourceCrawlerConfigurationModel] `tfsdk:"crawler_configuration"`
+	ProviderIdentity     fwtypes.ListNestedObjectValueOf[awsIdentityModel]                         `tfsdk:"provider_identity"`
+}
+
+type customLogSourceCrawlerConfigurationModel struct {
+	RoleARN fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+type awsIdentityModel struct {
+	ExternalID types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+type customLogSourceProviderModel struct {
+	Location types.String `tfsdk:"location"`
+	RoleARN  fwtypes.ARN  `tfsdk:"role_arn"`
+}
@@ -5,9 +5,15 @@ package securitylake
 
 // Exports for use in tests only.
 var (
-	ResourceAWSLogSource = newAWSLogSourceResource
-	ResourceDataLake     = newDataLakeResource
+	ResourceAWSLogSource           = newAWSLogSourceResource
+	ResourceCustomLogSource        = newCustomLogSourceResource
+	ResourceDataLake               = newDataLakeResource
+	ResourceSubscriber             = newSubscriberResource
+	ResourceSubscriberNotification = newSubscriberNotificationResource
 
-	FindAWSLogSourceBySourceName = findAWSLogSourceBySourceName
-	FindDataLakeByARN            = findDataLakeByARN
+	FindAWSLogSourceBySourceName             = findAWSLogSourceBySourceName
+	FindCustomLogSourceBySourceName          = findCustomLogSourceBySourceName
+	FindDataLakeByARN                        = findDataLakeByARN
+	FindSubscriberByID                       = findSubscriberByID
+	FindSubscriberNotificationBySubscriberID = findSubscriberNotificationBySubscriberID
 )
@@ -24,6 +24,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newAWSLogSourceResource,
 			Name:    "AWS Log Source",
 		},
+		{
+			Factory: newCustomLogSourceResource,
+			Name:    "Custom Log Source",
+		},
 		{
 			Factory: newDataLakeResource,
 			Name:    "Data Lake",
@@ -31,6 +35,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory: newSubscriberResource,
+			Name:    "Subscriber",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory: newSubscriberNotificationResource,
+			Name:    "Subscriber Notification",
+		},
 	}
 }
 
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber Notification")
+func newSubscriberNotificationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &subscriberNotificationResource{}
+
+	return r, nil
+}
+
+const (
+	ResNameSubscriberNotification = "Subscriber Notification"
+)
+
+type subscriberNotificationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *subscriberNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_securitylake_subscriber_notification"
+}
+
+func (r *subscriberNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"subscriber_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberNotificationConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"https_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[httpsNotificationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("https_notification_configuration"),
+									path.MatchRelative().AtParent().AtName("sqs_notification_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorization_api_key_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"authorization_api_key_value": schema.StringAttribute{
+										Optional:  true,
+										Sensitive: true,
+									},
+									"endpoint": schema.StringAttribute{
+										Required: true,
+									},
+									"http_method": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HttpMethod](),
+										Optional:   true,
+									},
+									"target_role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"sqs_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sqsNotificationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *subscriberNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data subscriberNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We can't use AutoFlEx with the top-level resource model because the API structure uses Go interfaces.
+	configuration, diags := expandSubscriberNotificationConfiguration(ctx, data.Configuration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateSubscriberNotificationInput{
+		Configuration: configuration,
+		SubscriberId:  flex.StringFromFramework(ctx, data.SubscriberID),
+	}
+
+	output, err := conn.CreateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionCreating, ResNameSubscriberNotification, data.SubscriberID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	data.EndpointID = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	data.ID = data.SubscriberID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data subscriberNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriber, err := findSubscriberNotificationBySubscriberID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameSubscriberNotification, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The notification configuration is not returned by the API.
+	data.EndpointID = flex.StringToFramework(ctx, subscriber.SubscriberEndpoint)
+	data.SubscriberID = flex.StringToFramework(ctx, subscriber.SubscriberId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var old, new subscriberNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Configuration.Equal(old.Configuration) {
+		configuration, diags := expandSubscriberNotificationConfiguration(ctx, new.Configuration)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input := &securitylake.UpdateSubscriberNotificationInput{
+			Configuration: configuration,
+			SubscriberId:  flex.StringFromFramework(ctx, new.ID),
+		}
+
+		output, err := conn.UpdateSubscriberNotification(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionUpdating, ResNameSubscriberNotification, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		new.EndpointID = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	} else {
+		new.EndpointID = old.EndpointID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *subscriberNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data subscriberNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteSubscriberNotification(ctx, &securitylake.DeleteSubscriberNotificationInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionDeleting, ResNameSubscriberNotification, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findSubscriberNotificationBySubscriberID(ctx context.Context, conn *securitylake.Client, subscriberID string) (*awstypes.SubscriberResource, error) {
+	output, err := findSubscriberByID(ctx, conn, subscriberID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.SubscriberEndpoint == nil {
+		return nil, &retry.NotFoundError{
+			Message: "subscriber notification not found",
+		}
+	}
+
+	return output, nil
+}
+
+func expandSubscriberNotificationConfiguration(ctx context.Context, configuration fwtypes.ListNestedObjectValueOf[subscriberNotificationConfigurationModel]) (awstypes.NotificationConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := configuration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if !data.HTTPSNotificationConfiguration.IsNull() {
+		httpsNotificationConfiguration, d := data.HTTPSNotificationConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.NotificationConfigurationMemberHttpsNotificationConfiguration{}
+		diags.Append(flex.Expand(ctx, httpsNotificationConfiguration, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return apiObject, diags
+	}
+
+	if !data.SQSNotificationConfiguration.IsNull() {
+		return &awstypes.NotificationConfigurationMemberSqsNotificationConfiguration{}, diags
+	}
+
+	return nil, diags
+}
+
+type subscriberNotificationResourceModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[subscriberNotificationConfigurationModel] `tfsdk:"configuration"`
+	EndpointID    types.String                                                              `tfsdk:"endpoint_id"`
+	ID            types.String                                                              `tfsdk:"id"`
+	SubscriberID  types.String                                                              `tfsdk:"subscriber_id"`
+}
+
+type subscriberNotificationConfigurationModel struct {
+	HTTPSNotificationConfiguration fwtypes.ListNestedObjectValueOf[httpsNotificationConfigurationModel] `tfsdk:"https_notification_configuration"`
+	SQSNotificationConfiguration   fwtypes.ListNestedObjectValueOf[sqsNotificationConfigurationModel]   `tfsdk:"sqs_notification_configuration"`
+}
+
+type httpsNotificationConfigurationModel struct {
+	AuthorizationAPIKeyName  types.String                            `tfsdk:"authorization_api_key_name"`
+	AuthorizationAPIKeyValue types.String                            `tfsdk:"authorization_api_key_value"`
+	Endpoint                 types.String                            `tfsdk:"endpoint"`
+	HTTPMethod               fwtypes.StringEnum[awstypes.HttpMethod] `tfsdk:"http_method"`
+	TargetRoleARN            fwtypes.ARN                             `tfsdk:"target_role_arn"`
+}
+
+type sqsNotificationConfigurationModel struct{}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="State Machines")
+func newDataSourceStateMachines(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceStateMachines{}, nil
+}
+
+const (
+	DSNameStateMachines = "State Machines Data Source"
+)
+
+type dataSourceStateMachines struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceStateMachines) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_sfn_state_machines"
+}
+
+func (d *dataSourceStateMachines) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"state_machines": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineSummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						"creation_date": schema.StringAttribute{
+							CustomType: fwtypes.TimestampType,
+							Computed:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceStateMachines) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().SFNConn(ctx)
+
+	var data dataSourceStateMachinesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var arns []string
+	var summaries []*stateMachineSummaryData
+
+	err := conn.ListStateMachinesPagesWithContext(ctx, &sfn.ListStateMachinesInput{}, func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StateMachines {
+			if v == nil {
+				continue
+			}
+
+			summary := &stateMachineSummaryData{
+				ARN:          flex.StringToFramework(ctx, v.StateMachineArn),
+				CreationDate: fwtypes.TimestampNull(),
+				Name:         flex.StringToFramework(ctx, v.Name),
+				Type:         flex.StringToFramework(ctx, v.Type),
+			}
+			if v.CreationDate != nil {
+				summary.CreationDate = fwtypes.TimestampValue(aws.TimeValue(v.CreationDate).Format(time.RFC3339))
+			}
+
+			arns = append(arns, aws.StringValue(v.StateMachineArn))
+			summaries = append(summaries, summary)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SFN, create.ErrActionReading, DSNameStateMachines, d.Meta().Region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.ID = types.StringValue(d.Meta().Region)
+	data.StateMachines = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceStateMachinesData struct {
+	ARNs          types.List                                               `tfsdk:"arns"`
+	ID            types.String                                             `tfsdk:"id"`
+	StateMachines fwtypes.ListNestedObjectValueOf[stateMachineSummaryData] `tfsdk:"state_machines"`
+}
+
+type stateMachineSummaryData struct {
+	ARN          types.String      `tfsdk:"arn"`
+	CreationDate fwtypes.Timestamp `tfsdk:"creation_date"`
+	Name         types.String      `tfsdk:"name"`
+	Type         types.String      `tfsdk:"type"`
+}
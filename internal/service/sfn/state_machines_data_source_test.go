@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNStateMachinesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sfn_state_machines.test"
+	resourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachinesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "state_machines.*", map[string]string{
+						"name": rName,
+						"type": "STANDARD",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "state_machines.*.creation_date", resourceName, "creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStateMachinesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_basic(rName, 5), `
+data "aws_sfn_state_machines" "test" {
+  depends_on = [aws_sfn_state_machine.test]
+}
+`)
+}
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Proactive Engagement")
+func newResourceProactiveEngagement(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceProactiveEngagement{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameProactiveEngagement = "Proactive Engagement"
+)
+
+type resourceProactiveEngagement struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceProactiveEngagement) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_shield_proactive_engagement"
+}
+
+func (r *resourceProactiveEngagement) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"id": framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"emergency_contact": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[emergencyContactModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"contact_notes": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 1024),
+							},
+						},
+						"email_address": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 150),
+							},
+						},
+						"phone_number": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 16),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceProactiveEngagement) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var plan resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.Meta().AccountID
+
+	contacts, diags := expandEmergencyContacts(ctx, plan.EmergencyContacts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := findProactiveEngagementStatus(ctx, conn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameProactiveEngagement, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The first configuration of proactive engagement for a subscription must go through
+	// AssociateProactiveEngagementDetails, which also enables it.
+	if status == "" {
+		_, err = conn.AssociateProactiveEngagementDetailsWithContext(ctx, &shield.AssociateProactiveEngagementDetailsInput{
+			EmergencyContactList: contacts,
+		})
+	} else {
+		_, err = conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: contacts,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameProactiveEngagement, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if err := updateProactiveEngagement(ctx, conn, plan.Enabled.ValueBool(), r.CreateTimeout(ctx, plan.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameProactiveEngagement, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceProactiveEngagement) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var state resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := findProactiveEngagementStatus(ctx, conn)
+	if err == nil && status == "" {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionSetting, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, &shield.DescribeEmergencyContactSettingsInput{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionSetting, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if len(out.EmergencyContactList) == 0 && status == shield.ProactiveEngagementStatusDisabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Enabled = types.BoolValue(status == shield.ProactiveEngagementStatusEnabled)
+	state.EmergencyContacts = flattenEmergencyContacts(ctx, out.EmergencyContactList)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProactiveEngagement) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var plan, state resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.EmergencyContacts.Equal(state.EmergencyContacts) {
+		contacts, diags := expandEmergencyContacts(ctx, plan.EmergencyContacts)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: contacts,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Shield, create.ErrActionUpdating, ResNameProactiveEngagement, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		if err := updateProactiveEngagement(ctx, conn, plan.Enabled.ValueBool(), r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Shield, create.ErrActionUpdating, ResNameProactiveEngagement, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceProactiveEngagement) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var state resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := updateProactiveEngagement(ctx, conn, false, r.DeleteTimeout(ctx, state.Timeouts)); err != nil {
+		if errs.IsA[*shield.ResourceNotFoundException](err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if errs.IsA[*shield.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceProactiveEngagement) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func updateProactiveEngagement(ctx context.Context, conn *shield.Shield, enabled bool, timeout time.Duration) error {
+	status, err := findProactiveEngagementStatus(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	target := shield.ProactiveEngagementStatusDisabled
+	if enabled {
+		target = shield.ProactiveEngagementStatusEnabled
+	}
+
+	switch {
+	case status == target:
+		return nil
+	case status == "" && !enabled:
+		// Proactive engagement has never been configured, so there is nothing to disable.
+		return nil
+	case status == shield.ProactiveEngagementStatusPending && enabled:
+		// Enablement is already in progress.
+	case enabled:
+		_, err = conn.EnableProactiveEngagementWithContext(ctx, &shield.EnableProactiveEngagementInput{})
+	default:
+		_, err = conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waitProactiveEngagementStatus(ctx, conn, target, timeout)
+
+	return err
+}
+
+func waitProactiveEngagementStatus(ctx context.Context, conn *shield.Shield, target string, timeout time.Duration) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{shield.ProactiveEngagementStatusPending, shield.ProactiveEngagementStatusEnabled, shield.ProactiveEngagementStatusDisabled},
+		Target:                    []string{target},
+		Refresh:                   statusProactiveEngagement(ctx, conn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(string); ok {
+		return out, err
+	}
+
+	return "", err
+}
+
+func statusProactiveEngagement(ctx context.Context, conn *shield.Shield) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := findProactiveEngagementStatus(ctx, conn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return status, status, nil
+	}
+}
+
+func findProactiveEngagementStatus(ctx context.Context, conn *shield.Shield) (string, error) {
+	subscription, err := findSubscription(ctx, conn)
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(subscription.ProactiveEngagementStatus), nil
+}
+
+func expandEmergencyContacts(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[emergencyContactModel]) ([]*shield.EmergencyContact, diag.Diagnostics) {
+	data, diags := tfList.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]*shield.EmergencyContact, 0, len(data))
+	for _, v := range data {
+		apiObjects = append(apiObjects, &shield.EmergencyContact{
+			ContactNotes: flex.StringFromFramework(ctx, v.ContactNotes),
+			EmailAddress: flex.StringFromFramework(ctx, v.EmailAddress),
+			PhoneNumber:  flex.StringFromFramework(ctx, v.PhoneNumber),
+		})
+	}
+
+	return apiObjects, diags
+}
+
+func flattenEmergencyContacts(ctx context.Context, apiObjects []*shield.EmergencyContact) fwtypes.ListNestedObjectValueOf[emergencyContactModel] {
+	data := make([]*emergencyContactModel, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		data = append(data, &emergencyContactModel{
+			ContactNotes: flex.StringToFramework(ctx, apiObject.ContactNotes),
+			EmailAddress: flex.StringToFramework(ctx, apiObject.EmailAddress),
+			PhoneNumber:  flex.StringToFramework(ctx, apiObject.PhoneNumber),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSlice(ctx, data)
+}
+
+type resourceProactiveEngagementData struct {
+	EmergencyContacts fwtypes.ListNestedObjectValueOf[emergencyContactModel] `tfsdk:"emergency_contact"`
+	Enabled           types.Bool                                             `tfsdk:"enabled"`
+	ID                types.String                                           `tfsdk:"id"`
+	Timeouts          timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+type emergencyContactModel struct {
+	ContactNotes types.String `tfsdk:"contact_notes"`
+	EmailAddress types.String `tfsdk:"email_address"`
+	PhoneNumber  types.String `tfsdk:"phone_number"`
+}
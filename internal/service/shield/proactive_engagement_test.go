@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccShieldProactiveEngagement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSubscription(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "test1@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12358132134"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Notes"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", "test2@example.com"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShieldProactiveEngagement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSubscription(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProactiveEngagementExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfshield.ResourceProactiveEngagement, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProactiveEngagementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_shield_proactive_engagement" {
+				continue
+			}
+
+			subscription, err := tfshield.FindSubscription(ctx, conn)
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+				return create.Error(names.Shield, create.ErrActionCheckingDestroyed, tfshield.ResNameProactiveEngagement, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProactiveEngagementExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Shield, create.ErrActionCheckingExistence, tfshield.ResNameProactiveEngagement, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Shield, create.ErrActionCheckingExistence, tfshield.ResNameProactiveEngagement, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+		out, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, &shield.DescribeEmergencyContactSettingsInput{})
+		if err != nil {
+			return create.Error(names.Shield, create.ErrActionCheckingExistence, tfshield.ResNameProactiveEngagement, rs.Primary.ID, err)
+		}
+
+		if len(out.EmergencyContactList) == 0 {
+			return create.Error(names.Shield, create.ErrActionCheckingExistence, tfshield.ResNameProactiveEngagement, rs.Primary.ID, errors.New("no emergency contacts"))
+		}
+
+		return nil
+	}
+}
+
+func testAccProactiveEngagementConfig_basic(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Notes"
+    email_address = "test1@example.com"
+    phone_number  = "+12358132134"
+  }
+
+  emergency_contact {
+    contact_notes = "Notes 2"
+    email_address = "test2@example.com"
+    phone_number  = "+12358132134"
+  }
+}
+`, enabled)
+}
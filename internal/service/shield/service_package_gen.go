@@ -30,6 +30,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceDRTAccessRoleARNAssociation,
 			Name:    "DRT Access Role ARN Association",
 		},
+		{
+			Factory: newResourceProactiveEngagement,
+			Name:    "Proactive Engagement",
+		},
+		{
+			Factory: newResourceSubscription,
+			Name:    "Subscription",
+		},
 	}
 }
 
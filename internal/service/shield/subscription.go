@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscription")
+func newResourceSubscription(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSubscription{}, nil
+}
+
+const (
+	ResNameSubscription = "Subscription"
+)
+
+type resourceSubscription struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceSubscription) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_shield_subscription"
+}
+
+func (r *resourceSubscription) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"acknowledge_commitment": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"arn": framework.ARNAttributeComputedOnly(),
+			"auto_renew": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(shield.AutoRenewEnabled),
+				Validators: []validator.String{
+					stringvalidator.OneOf(shield.AutoRenew_Values()...),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"skip_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"start_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSubscription) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var plan resourceSubscriptionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.Meta().AccountID
+
+	// A subscription is an account-wide singleton. If one is already active it is adopted.
+	_, err := conn.CreateSubscriptionWithContext(ctx, &shield.CreateSubscriptionInput{})
+	if err != nil && !errs.IsA[*shield.ResourceAlreadyExistsException](err) {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameSubscription, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findSubscription(ctx, conn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionReading, ResNameSubscription, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if aws.StringValue(out.AutoRenew) != plan.AutoRenew.ValueString() {
+		if err := updateSubscriptionAutoRenew(ctx, conn, plan.AutoRenew.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameSubscription, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		out.AutoRenew = aws.String(plan.AutoRenew.ValueString())
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.refreshFromOutput(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceSubscription) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var state resourceSubscriptionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSubscription(ctx, conn)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionSetting, ResNameSubscription, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	if state.AcknowledgeCommitment.IsNull() {
+		state.AcknowledgeCommitment = types.BoolValue(false)
+	}
+	if state.SkipDestroy.IsNull() {
+		state.SkipDestroy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSubscription) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var plan, state resourceSubscriptionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AutoRenew.Equal(state.AutoRenew) {
+		if err := updateSubscriptionAutoRenew(ctx, conn, plan.AutoRenew.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Shield, create.ErrActionUpdating, ResNameSubscription, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete cannot cancel a Shield Advanced subscription before the end of its commitment period.
+// With skip_destroy set the resource is only removed from state. Otherwise destroying the resource
+// requires acknowledge_commitment, and then disables automatic renewal so that the subscription lapses.
+func (r *resourceSubscription) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var state resourceSubscriptionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.SkipDestroy.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Shield Advanced subscription not cancelled",
+			fmt.Sprintf("The subscription was removed from state with automatic renewal unchanged (%s). It remains active and billed until %s.", state.AutoRenew.ValueString(), state.EndTime.ValueString()),
+		)
+		return
+	}
+
+	if !state.AcknowledgeCommitment.ValueBool() {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameSubscription, state.ID.String(), errors.New("commitment not acknowledged")),
+			"A Shield Advanced subscription cannot be cancelled before the end of its commitment period and continues to be billed until then. "+
+				"To destroy this resource, first set acknowledge_commitment to true and apply.",
+		)
+		return
+	}
+
+	err := updateSubscriptionAutoRenew(ctx, conn, shield.AutoRenewDisabled)
+
+	if errs.IsA[*shield.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameSubscription, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Shield Advanced subscription not cancelled",
+		fmt.Sprintf("Automatic renewal was disabled. The subscription remains active and billed until the end of its commitment period at %s.", state.EndTime.ValueString()),
+	)
+}
+
+func (r *resourceSubscription) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func updateSubscriptionAutoRenew(ctx context.Context, conn *shield.Shield, autoRenew string) error {
+	_, err := conn.UpdateSubscriptionWithContext(ctx, &shield.UpdateSubscriptionInput{
+		AutoRenew: aws.String(autoRenew),
+	})
+
+	return err
+}
+
+func findSubscription(ctx context.Context, conn *shield.Shield) (*shield.Subscription, error) {
+	stateIn := &shield.GetSubscriptionStateInput{}
+	stateOut, err := conn.GetSubscriptionStateWithContext(ctx, stateIn)
+	if err != nil {
+		return nil, err
+	}
+
+	if stateOut == nil {
+		return nil, tfresource.NewEmptyResultError(stateIn)
+	}
+
+	if state := aws.StringValue(stateOut.SubscriptionState); state == shield.SubscriptionStateInactive {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: stateIn,
+		}
+	}
+
+	in := &shield.DescribeSubscriptionInput{}
+	out, err := conn.DescribeSubscriptionWithContext(ctx, in)
+
+	if errs.IsA[*shield.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Subscription == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Subscription, nil
+}
+
+type resourceSubscriptionData struct {
+	AcknowledgeCommitment types.Bool   `tfsdk:"acknowledge_commitment"`
+	ARN                   types.String `tfsdk:"arn"`
+	AutoRenew             types.String `tfsdk:"auto_renew"`
+	EndTime               types.String `tfsdk:"end_time"`
+	ID                    types.String `tfsdk:"id"`
+	SkipDestroy           types.Bool   `tfsdk:"skip_destroy"`
+	StartTime             types.String `tfsdk:"start_time"`
+}
+
+func (data *resourceSubscriptionData) refreshFromOutput(ctx context.Context, out *shield.Subscription) {
+	data.ARN = flex.StringToFramework(ctx, out.SubscriptionArn)
+	data.AutoRenew = flex.StringToFramework(ctx, out.AutoRenew)
+	data.EndTime = flattenSubscriptionTime(out.EndTime)
+	data.StartTime = flattenSubscriptionTime(out.StartTime)
+}
+
+func flattenSubscriptionTime(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(aws.TimeValue(t).Format(time.RFC3339))
+}
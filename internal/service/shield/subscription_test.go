@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Subscribing to Shield Advanced incurs a one year commitment, so these tests only run when explicitly acknowledged.
+func TestAccShieldSubscription_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.SkipIfEnvVarNotSet(t, "AWS_SHIELD_ENABLE_SUBSCRIPTION_TEST")
+
+	var subscription shield.Subscription
+	resourceName := "aws_shield_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionConfig_basic(shield.AutoRenewEnabled, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "acknowledge_commitment", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewEnabled),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acknowledge_commitment", "skip_destroy"},
+			},
+			{
+				Config: testAccSubscriptionConfig_basic(shield.AutoRenewDisabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "acknowledge_commitment", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShieldSubscription_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.SkipIfEnvVarNotSet(t, "AWS_SHIELD_ENABLE_SUBSCRIPTION_TEST")
+
+	var subscription shield.Subscription
+	resourceName := "aws_shield_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionAutoRenewUnchanged(ctx, shield.AutoRenewEnabled),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionConfig_skipDestroy(shield.AutoRenewEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "acknowledge_commitment", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewEnabled),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+// The subscription remains active after destroy; only automatic renewal is disabled.
+func testAccCheckSubscriptionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_shield_subscription" {
+				continue
+			}
+
+			subscription, err := tfshield.FindSubscription(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if rs.Primary.Attributes["skip_destroy"] != "true" && aws.StringValue(subscription.AutoRenew) != shield.AutoRenewDisabled {
+				return create.Error(names.Shield, create.ErrActionCheckingDestroyed, tfshield.ResNameSubscription, rs.Primary.ID, errors.New("auto renewal still enabled"))
+			}
+		}
+
+		return nil
+	}
+}
+
+// With skip_destroy, destroy leaves the subscription and its automatic renewal untouched.
+func testAccCheckSubscriptionAutoRenewUnchanged(ctx context.Context, autoRenew string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_shield_subscription" {
+				continue
+			}
+
+			subscription, err := tfshield.FindSubscription(ctx, conn)
+
+			if err != nil {
+				return create.Error(names.Shield, create.ErrActionCheckingDestroyed, tfshield.ResNameSubscription, rs.Primary.ID, err)
+			}
+
+			if got := aws.StringValue(subscription.AutoRenew); got != autoRenew {
+				return create.Error(names.Shield, create.ErrActionCheckingDestroyed, tfshield.ResNameSubscription, rs.Primary.ID, fmt.Errorf("auto renewal is %s, expected %s", got, autoRenew))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubscriptionExists(ctx context.Context, name string, subscription *shield.Subscription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Shield, create.ErrActionCheckingExistence, tfshield.ResNameSubscription, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Shield, create.ErrActionCheckingExistence, tfshield.ResNameSubscription, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+		out, err := tfshield.FindSubscription(ctx, conn)
+		if err != nil {
+			return create.Error(names.Shield, create.ErrActionCheckingExistence, tfshield.ResNameSubscription, rs.Primary.ID, err)
+		}
+
+		*subscription = *out
+
+		return nil
+	}
+}
+
+func testAccPreCheckSubscription(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+	_, err := tfshield.FindSubscription(ctx, conn)
+	if acctest.PreCheckSkipError(err) || tfresource.NotFound(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccSubscriptionConfig_basic(autoRenew string, acknowledgeCommitment bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_subscription" "test" {
+  auto_renew             = %[1]q
+  acknowledge_commitment = %[2]t
+}
+`, autoRenew, acknowledgeCommitment)
+}
+
+func testAccSubscriptionConfig_skipDestroy(autoRenew string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_subscription" "test" {
+  auto_renew   = %[1]q
+  skip_destroy = true
+}
+`, autoRenew)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Topics")
+func newDataSourceTopics(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTopics{}, nil
+}
+
+const (
+	DSNameTopics = "Topics Data Source"
+)
+
+type dataSourceTopics struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTopics) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_sns_topics"
+}
+
+func (d *dataSourceTopics) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"topics": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[topicSummaryData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTopics) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().SNSClient(ctx)
+
+	var data dataSourceTopicsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topics, err := findTopics(ctx, conn, &sns.ListTopicsInput{}, tfslices.PredicateTrue[awstypes.Topic]())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SNS, create.ErrActionReading, DSNameTopics, d.Meta().Region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var arns []string
+	var summaries []*topicSummaryData
+
+	for _, v := range topics {
+		topicARN := aws.ToString(v.TopicArn)
+		summary := &topicSummaryData{
+			ARN: types.StringValue(topicARN),
+		}
+
+		// Topic names are not returned by ListTopics; they are the ARN's resource part.
+		if parsedARN, err := arn.Parse(topicARN); err == nil {
+			summary.Name = types.StringValue(parsedARN.Resource)
+		} else {
+			summary.Name = types.StringNull()
+		}
+
+		arns = append(arns, topicARN)
+		summaries = append(summaries, summary)
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, arns)
+	data.ID = types.StringValue(d.Meta().Region)
+	data.Topics = fwtypes.NewListNestedObjectValueOfSlice(ctx, summaries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceTopicsData struct {
+	ARNs   types.List                                        `tfsdk:"arns"`
+	ID     types.String                                      `tfsdk:"id"`
+	Topics fwtypes.ListNestedObjectValueOf[topicSummaryData] `tfsdk:"topics"`
+}
+
+type topicSummaryData struct {
+	ARN  types.String `tfsdk:"arn"`
+	Name types.String `tfsdk:"name"`
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sns_topic.test"
+	datasourceName := "data.aws_sns_topics.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "topics.*.arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "topics.*.name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTopicsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+data "aws_sns_topics" "test" {
+  depends_on = [aws_sns_topic.test]
+}
+`, rName)
+}
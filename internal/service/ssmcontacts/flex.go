@@ -197,3 +197,269 @@ func flattenContactTargetInfo(contactTargetInfo *types.ContactTargetInfo) []inte
 
 	return result
 }
+
+func expandRecurrenceSettings(recurrence []interface{}) *types.RecurrenceSettings {
+	if len(recurrence) == 0 || recurrence[0] == nil {
+		return nil
+	}
+
+	m := recurrence[0].(map[string]interface{})
+
+	r := &types.RecurrenceSettings{}
+
+	if v, ok := m["daily_settings"].([]interface{}); ok && len(v) > 0 {
+		for _, h := range v {
+			if h, ok := h.(map[string]interface{}); ok {
+				r.DailySettings = append(r.DailySettings, *expandHandOffTime(h))
+			}
+		}
+	}
+
+	if v, ok := m["monthly_settings"].([]interface{}); ok && len(v) > 0 {
+		r.MonthlySettings = expandMonthlySettings(v)
+	}
+
+	if v, ok := m["number_of_on_calls"].(int); ok {
+		r.NumberOfOnCalls = aws.Int32(int32(v))
+	}
+
+	if v, ok := m["recurrence_multiplier"].(int); ok {
+		r.RecurrenceMultiplier = aws.Int32(int32(v))
+	}
+
+	if v, ok := m["shift_coverages"].([]interface{}); ok && len(v) > 0 {
+		r.ShiftCoverages = expandShiftCoverages(v)
+	}
+
+	if v, ok := m["weekly_settings"].([]interface{}); ok && len(v) > 0 {
+		r.WeeklySettings = expandWeeklySettings(v)
+	}
+
+	return r
+}
+
+func flattenRecurrenceSettings(recurrence *types.RecurrenceSettings) []interface{} {
+	if recurrence == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := recurrence.DailySettings; v != nil {
+		var dailySettings []interface{}
+		for _, h := range v {
+			h := h
+			dailySettings = append(dailySettings, flattenHandOffTime(&h))
+		}
+		m["daily_settings"] = dailySettings
+	}
+
+	if v := recurrence.MonthlySettings; v != nil {
+		m["monthly_settings"] = flattenMonthlySettings(v)
+	}
+
+	if v := recurrence.NumberOfOnCalls; v != nil {
+		m["number_of_on_calls"] = aws.ToInt32(v)
+	}
+
+	if v := recurrence.RecurrenceMultiplier; v != nil {
+		m["recurrence_multiplier"] = aws.ToInt32(v)
+	}
+
+	if v := recurrence.ShiftCoverages; v != nil {
+		m["shift_coverages"] = flattenShiftCoverages(v)
+	}
+
+	if v := recurrence.WeeklySettings; v != nil {
+		m["weekly_settings"] = flattenWeeklySettings(v)
+	}
+
+	return []interface{}{m}
+}
+
+func expandHandOffTime(handOffTime map[string]interface{}) *types.HandOffTime {
+	h := &types.HandOffTime{}
+
+	if v, ok := handOffTime["hour_of_day"].(int); ok {
+		h.HourOfDay = int32(v)
+	}
+
+	if v, ok := handOffTime["minute_of_hour"].(int); ok {
+		h.MinuteOfHour = int32(v)
+	}
+
+	return h
+}
+
+func expandHandOffTimeList(handOffTime []interface{}) *types.HandOffTime {
+	if len(handOffTime) == 0 || handOffTime[0] == nil {
+		return nil
+	}
+
+	return expandHandOffTime(handOffTime[0].(map[string]interface{}))
+}
+
+func flattenHandOffTime(handOffTime *types.HandOffTime) map[string]interface{} {
+	return map[string]interface{}{
+		"hour_of_day":    handOffTime.HourOfDay,
+		"minute_of_hour": handOffTime.MinuteOfHour,
+	}
+}
+
+func flattenHandOffTimeList(handOffTime *types.HandOffTime) []interface{} {
+	if handOffTime == nil {
+		return nil
+	}
+
+	return []interface{}{flattenHandOffTime(handOffTime)}
+}
+
+func expandMonthlySettings(monthlySettings []interface{}) []types.MonthlySetting {
+	var result []types.MonthlySetting
+
+	for _, monthlySetting := range monthlySettings {
+		m, ok := monthlySetting.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		s := types.MonthlySetting{}
+
+		if v, ok := m["day_of_month"].(int); ok {
+			s.DayOfMonth = aws.Int32(int32(v))
+		}
+
+		if v, ok := m["hand_off_time"].([]interface{}); ok {
+			s.HandOffTime = expandHandOffTimeList(v)
+		}
+
+		result = append(result, s)
+	}
+
+	return result
+}
+
+func flattenMonthlySettings(monthlySettings []types.MonthlySetting) []interface{} {
+	var result []interface{}
+
+	for _, monthlySetting := range monthlySettings {
+		m := map[string]interface{}{}
+
+		if v := monthlySetting.DayOfMonth; v != nil {
+			m["day_of_month"] = aws.ToInt32(v)
+		}
+
+		m["hand_off_time"] = flattenHandOffTimeList(monthlySetting.HandOffTime)
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func expandWeeklySettings(weeklySettings []interface{}) []types.WeeklySetting {
+	var result []types.WeeklySetting
+
+	for _, weeklySetting := range weeklySettings {
+		m, ok := weeklySetting.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		s := types.WeeklySetting{}
+
+		if v, ok := m["day_of_week"].(string); ok {
+			s.DayOfWeek = types.DayOfWeek(v)
+		}
+
+		if v, ok := m["hand_off_time"].([]interface{}); ok {
+			s.HandOffTime = expandHandOffTimeList(v)
+		}
+
+		result = append(result, s)
+	}
+
+	return result
+}
+
+func flattenWeeklySettings(weeklySettings []types.WeeklySetting) []interface{} {
+	var result []interface{}
+
+	for _, weeklySetting := range weeklySettings {
+		m := map[string]interface{}{
+			"day_of_week":   string(weeklySetting.DayOfWeek),
+			"hand_off_time": flattenHandOffTimeList(weeklySetting.HandOffTime),
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func expandShiftCoverages(shiftCoverages []interface{}) map[string][]types.CoverageTime {
+	result := make(map[string][]types.CoverageTime)
+
+	for _, shiftCoverage := range shiftCoverages {
+		m, ok := shiftCoverage.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var coverageTimes []types.CoverageTime
+
+		if v, ok := m["coverage_times"].([]interface{}); ok {
+			for _, coverageTime := range v {
+				c, ok := coverageTime.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				t := types.CoverageTime{}
+
+				if v, ok := c["end"].([]interface{}); ok {
+					t.End = expandHandOffTimeList(v)
+				}
+
+				if v, ok := c["start"].([]interface{}); ok {
+					t.Start = expandHandOffTimeList(v)
+				}
+
+				coverageTimes = append(coverageTimes, t)
+			}
+		}
+
+		result[m["day_of_week"].(string)] = coverageTimes
+	}
+
+	return result
+}
+
+// flattenShiftCoverages returns shift coverages ordered by day of the week,
+// as the API returns them as an unordered map.
+func flattenShiftCoverages(shiftCoverages map[string][]types.CoverageTime) []interface{} {
+	var result []interface{}
+
+	for _, day := range types.DayOfWeek("").Values() {
+		coverageTimes, ok := shiftCoverages[string(day)]
+		if !ok {
+			continue
+		}
+
+		var c []interface{}
+
+		for _, coverageTime := range coverageTimes {
+			c = append(c, map[string]interface{}{
+				"end":   flattenHandOffTimeList(coverageTime.End),
+				"start": flattenHandOffTimeList(coverageTime.Start),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"coverage_times": c,
+			"day_of_week":    string(day),
+		})
+	}
+
+	return result
+}
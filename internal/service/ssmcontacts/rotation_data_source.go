@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ssmcontacts_rotation")
+func DataSourceRotation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRotationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"contact_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurrence": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     handOffTimeDataSourceResource(),
+						},
+						"monthly_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_month": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     handOffTimeDataSourceResource(),
+									},
+								},
+							},
+						},
+						"number_of_on_calls": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"recurrence_multiplier": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"shift_coverages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"coverage_times": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     handOffTimeDataSourceResource(),
+												},
+												"start": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     handOffTimeDataSourceResource(),
+												},
+											},
+										},
+									},
+									"day_of_week": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"weekly_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_week": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     handOffTimeDataSourceResource(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"time_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func handOffTimeDataSourceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hour_of_day": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"minute_of_hour": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameRotation = "Rotation Data Source"
+)
+
+func dataSourceRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	arn := d.Get("arn").(string)
+
+	out, err := findRotationByID(ctx, conn, arn)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, DSNameRotation, arn, err)
+	}
+
+	d.SetId(aws.ToString(out.RotationArn))
+
+	if err := setRotationResourceData(d, out); err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionSetting, DSNameRotation, d.Id(), err)
+	}
+
+	tags, err := listTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, DSNameRotation, d.Id(), err)
+	}
+
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionSetting, DSNameRotation, d.Id(), err)
+	}
+
+	return nil
+}
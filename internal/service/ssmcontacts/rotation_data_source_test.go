@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testRotationDataSource_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmcontacts_rotation.test"
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "contact_ids.#", resourceName, "contact_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.#", resourceName, "recurrence.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.0.weekly_settings.#", resourceName, "recurrence.0.weekly_settings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.0.shift_coverages.#", resourceName, "recurrence.0.shift_coverages.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "start_time", resourceName, "start_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "time_zone_id", resourceName, "time_zone_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRotationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_weeklySettings(rName),
+		`
+data "aws_ssmcontacts_rotation" "test" {
+  arn = aws_ssmcontacts_rotation.test.arn
+}
+`)
+}
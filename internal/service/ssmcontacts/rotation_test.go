@@ -0,0 +1,503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testRotation_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexache.MustCompile(`rotation/+.`)),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.0", "aws_ssmcontacts_contact.test.0", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.minute_of_hour", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.number_of_on_calls", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.recurrence_multiplier", "1"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_id", "Australia/Sydney"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// We need to explicitly test destroying this resource instead of just using CheckDestroy,
+				// because CheckDestroy will run after the replication set has been destroyed and destroying
+				// the replication set will destroy all other resources.
+				Config: testAccContactConfig_none(),
+				Check:  testAccCheckRotationDestroy(ctx),
+			},
+		},
+	})
+}
+
+func testRotation_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssmcontacts.ResourceRotation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testRotation_contactIds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_contactIds(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.0", "aws_ssmcontacts_contact.test.0", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.1", "aws_ssmcontacts_contact.test.1", "arn"),
+				),
+			},
+			{
+				Config: testAccRotationConfig_contactIds(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.2", "aws_ssmcontacts_contact.test.2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testRotation_recurrence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_weeklySettings(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.day_of_week", "MON"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.hand_off_time.0.hour_of_day", "4"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.1.day_of_week", "WED"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.day_of_week", "MON"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.coverage_times.0.start.0.hour_of_day", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.coverage_times.0.end.0.hour_of_day", "23"),
+				),
+			},
+			{
+				Config: testAccRotationConfig_monthlySettings(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.0.day_of_month", "20"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.0.hand_off_time.0.hour_of_day", "8"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.1.day_of_month", "13"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testRotation_updateTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccRotationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRotationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmcontacts_rotation" {
+				continue
+			}
+
+			_, err := conn.GetRotation(ctx, &ssmcontacts.GetRotationInput{
+				RotationId: aws.String(rs.Primary.ID),
+			})
+
+			if err != nil {
+				// Getting resources may return validation exception when the replication set has been destroyed
+				var ve *types.ValidationException
+				if errors.As(err, &ve) {
+					continue
+				}
+
+				var nfe *types.ResourceNotFoundException
+				if errors.As(err, &nfe) {
+					continue
+				}
+
+				return err
+			}
+
+			return create.Error(names.SSMContacts, create.ErrActionCheckingDestroyed, tfssmcontacts.ResNameRotation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRotationExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotation, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+
+		_, err := conn.GetRotation(ctx, &ssmcontacts.GetRotationInput{
+			RotationId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotation, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccRotationConfig_base(rName string, contactCount int) string {
+	return acctest.ConfigCompose(
+		testAccContactConfig_base(),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  count = %[2]d
+
+  alias = "%[1]s-${count.index}"
+  type  = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, contactCount))
+}
+
+func testAccRotationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_base(rName, 1),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = aws_ssmcontacts_contact.test[*].arn
+
+  name = %[1]q
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  time_zone_id = "Australia/Sydney"
+}
+`, rName))
+}
+
+func testAccRotationConfig_contactIds(rName string, contactCount int) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_base(rName, contactCount),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = aws_ssmcontacts_contact.test[*].arn
+
+  name = %[1]q
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  time_zone_id = "Australia/Sydney"
+}
+`, rName))
+}
+
+func testAccRotationConfig_weeklySettings(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_base(rName, 1),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = aws_ssmcontacts_contact.test[*].arn
+
+  name = %[1]q
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    weekly_settings {
+      day_of_week = "MON"
+
+      hand_off_time {
+        hour_of_day    = 4
+        minute_of_hour = 30
+      }
+    }
+
+    weekly_settings {
+      day_of_week = "WED"
+
+      hand_off_time {
+        hour_of_day    = 7
+        minute_of_hour = 30
+      }
+    }
+
+    shift_coverages {
+      day_of_week = "MON"
+
+      coverage_times {
+        start {
+          hour_of_day    = 1
+          minute_of_hour = 0
+        }
+
+        end {
+          hour_of_day    = 23
+          minute_of_hour = 0
+        }
+      }
+    }
+  }
+
+  start_time   = "2030-01-01T00:00:00Z"
+  time_zone_id = "Australia/Sydney"
+}
+`, rName))
+}
+
+func testAccRotationConfig_monthlySettings(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_base(rName, 1),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = aws_ssmcontacts_contact.test[*].arn
+
+  name = %[1]q
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    monthly_settings {
+      day_of_month = 20
+
+      hand_off_time {
+        hour_of_day    = 8
+        minute_of_hour = 0
+      }
+    }
+
+    monthly_settings {
+      day_of_month = 13
+
+      hand_off_time {
+        hour_of_day    = 12
+        minute_of_hour = 30
+      }
+    }
+  }
+
+  start_time   = "2030-01-01T00:00:00Z"
+  time_zone_id = "Australia/Sydney"
+}
+`, rName))
+}
+
+func testAccRotationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_base(rName, 1),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = aws_ssmcontacts_contact.test[*].arn
+
+  name = %[1]q
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  time_zone_id = "Australia/Sydney"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccRotationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_base(rName, 1),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = aws_ssmcontacts_contact.test[*].arn
+
+  name = %[1]q
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  time_zone_id = "Australia/Sydney"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -36,6 +36,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePlan,
 			TypeName: "aws_ssmcontacts_plan",
 		},
+		{
+			Factory:  DataSourceRotation,
+			TypeName: "aws_ssmcontacts_rotation",
+		},
 	}
 }
 
@@ -59,6 +63,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ssmcontacts_plan",
 			Name:     "Plan",
 		},
+		{
+			Factory:  ResourceRotation,
+			TypeName: "aws_ssmcontacts_rotation",
+			Name:     "Rotation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 	}
 }
 
@@ -51,6 +51,16 @@ func TestAccSSMContacts_serial(t *testing.T) {
 			"basic":             testPlanDataSource_basic,
 			"channelTargetInfo": testPlanDataSource_channelTargetInfo,
 		},
+		"Rotation Resource Tests": {
+			"basic":      testRotation_basic,
+			"disappears": testRotation_disappears,
+			"contactIds": testRotation_contactIds,
+			"recurrence": testRotation_recurrence,
+			"updateTags": testRotation_updateTags,
+		},
+		"Rotation Data Source Tests": {
+			"basic": testRotationDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// cedarStatementType is a string type for Cedar policy statements.
+// Statements that differ only in formatting are semantically equal.
+type cedarStatementType struct {
+	basetypes.StringType
+}
+
+var (
+	_ basetypes.StringTypable                    = (*cedarStatementType)(nil)
+	_ basetypes.StringValuable                   = (*cedarStatement)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*cedarStatement)(nil)
+)
+
+func (t cedarStatementType) Equal(o attr.Type) bool {
+	other, ok := o.(cedarStatementType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t cedarStatementType) String() string {
+	return "CedarStatementType"
+}
+
+func (t cedarStatementType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return cedarStatementNull(), diags
+	}
+	if in.IsUnknown() {
+		return cedarStatementUnknown(), diags
+	}
+
+	return cedarStatement{StringValue: in}, diags
+}
+
+func (t cedarStatementType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t cedarStatementType) ValueType(context.Context) attr.Value {
+	return cedarStatement{}
+}
+
+func cedarStatementNull() cedarStatement {
+	return cedarStatement{StringValue: basetypes.NewStringNull()}
+}
+
+func cedarStatementUnknown() cedarStatement {
+	return cedarStatement{StringValue: basetypes.NewStringUnknown()}
+}
+
+func cedarStatementValue(value string) cedarStatement {
+	return cedarStatement{StringValue: basetypes.NewStringValue(value)}
+}
+
+type cedarStatement struct {
+	basetypes.StringValue
+}
+
+func (v cedarStatement) Equal(o attr.Value) bool {
+	other, ok := o.(cedarStatement)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v cedarStatement) Type(context.Context) attr.Type {
+	return cedarStatementType{}
+}
+
+func (v cedarStatement) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(cedarStatement)
+
+	if !ok {
+		return false, diags
+	}
+
+	return normalizeCedarStatement(v.ValueString()) == normalizeCedarStatement(newValue.ValueString()), diags
+}
+
+// normalizeCedarStatement removes formatting from a Cedar policy statement.
+// Outside of string literals, runs of whitespace are collapsed to a single space and
+// whitespace next to '(', ')', ',' and ';' is removed. Leading and trailing whitespace is trimmed.
+func normalizeCedarStatement(statement string) string {
+	var sb strings.Builder
+	var inString, escaped, pendingSpace bool
+	var last rune
+
+	isPunctuation := func(r rune) bool {
+		return r == '(' || r == ')' || r == ',' || r == ';'
+	}
+
+	for _, r := range strings.TrimSpace(statement) {
+		if inString {
+			sb.WriteRune(r)
+
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				inString = false
+			}
+
+			last = r
+			continue
+		}
+
+		if unicode.IsSpace(r) {
+			pendingSpace = true
+			continue
+		}
+
+		if pendingSpace && !isPunctuation(last) && !isPunctuation(r) {
+			sb.WriteRune(' ')
+		}
+		pendingSpace = false
+
+		if r == '"' {
+			inString = true
+		}
+
+		sb.WriteRune(r)
+		last = r
+	}
+
+	return sb.String()
+}
@@ -5,15 +5,22 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
+	ResourceIdentitySource = newResourceIdentitySource
+	ResourcePolicy         = newResourcePolicy
 	ResourcePolicyStore    = newResourcePolicyStore
 	ResourcePolicyTemplate = newResourcePolicyTemplate
 	ResourceSchema         = newResourceSchema
 
+	FindIdentitySourceByID    = findIdentitySourceByID
+	FindPolicyByID            = findPolicyByID
 	FindPolicyStoreByID       = findPolicyStoreByID
 	FindPolicyTemplateByID    = findPolicyTemplateByID
 	FindSchemaByPolicyStoreID = findSchemaByPolicyStoreID
 )
 
 var (
-	PolicyTemplateParseID = policyTemplateParseID
+	IdentitySourceParseID   = identitySourceParseID
+	NormalizeCedarStatement = normalizeCedarStatement
+	PolicyParseID           = policyParseID
+	PolicyTemplateParseID   = policyTemplateParseID
 )
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIdentitySource{}
+
+	return r, nil
+}
+
+const (
+	ResNameIdentitySource = "Identity Source"
+)
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identitySourceConfiguration](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoUserPoolConfiguration](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Computed:    true,
+									},
+									"user_pool_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	cognitoUserPoolConfiguration := expandCognitoUserPoolConfiguration(ctx, plan.Configuration, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		PolicyStoreId: flex.StringFromFramework(ctx, plan.PolicyStoreID),
+	}
+
+	if cognitoUserPoolConfiguration != nil {
+		input.Configuration = &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{
+			Value: *cognitoUserPoolConfiguration,
+		}
+	}
+
+	if !plan.PrincipalEntityType.IsUnknown() {
+		input.PrincipalEntityType = flex.StringFromFramework(ctx, plan.PrincipalEntityType)
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringValueToFramework(ctx, fmt.Sprintf("%s:%s", aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId)))
+	state.IdentitySourceID = flex.StringToFramework(ctx, output.IdentitySourceId)
+
+	out, err := findIdentitySourceByID(ctx, conn, aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId))
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Configuration = flattenIdentitySourceConfiguration(ctx, out.Details)
+	state.PrincipalEntityType = flex.StringToFramework(ctx, out.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, identitySourceID, err := identitySourceParseID(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findIdentitySourceByID(ctx, conn, policyStoreID, identitySourceID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Configuration = flattenIdentitySourceConfiguration(ctx, output.Details)
+	state.IdentitySourceID = flex.StringToFramework(ctx, output.IdentitySourceId)
+	state.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+	state.PrincipalEntityType = flex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Configuration.Equal(state.Configuration) || !plan.PrincipalEntityType.Equal(state.PrincipalEntityType) {
+		cognitoUserPoolConfiguration := expandCognitoUserPoolConfiguration(ctx, plan.Configuration, &response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    flex.StringFromFramework(ctx, state.IdentitySourceID),
+			PolicyStoreId:       flex.StringFromFramework(ctx, state.PolicyStoreID),
+			PrincipalEntityType: flex.StringFromFramework(ctx, plan.PrincipalEntityType),
+		}
+
+		if cognitoUserPoolConfiguration != nil {
+			input.UpdateConfiguration = &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{
+				Value: awstypes.UpdateCognitoUserPoolConfiguration{
+					ClientIds:   cognitoUserPoolConfiguration.ClientIds,
+					UserPoolArn: cognitoUserPoolConfiguration.UserPoolArn,
+				},
+			}
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		out, err := findIdentitySourceByID(ctx, conn, state.PolicyStoreID.ValueString(), state.IdentitySourceID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.Configuration = flattenIdentitySourceConfiguration(ctx, out.Details)
+		plan.PrincipalEntityType = flex.StringToFramework(ctx, out.PrincipalEntityType)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Verified Permissions Identity Source", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	input := &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: aws.String(state.IdentitySourceID.ValueString()),
+		PolicyStoreId:    aws.String(state.PolicyStoreID.ValueString()),
+	}
+
+	_, err := conn.DeleteIdentitySource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type resourceIdentitySourceData struct {
+	Configuration       fwtypes.ListNestedObjectValueOf[identitySourceConfiguration] `tfsdk:"configuration"`
+	ID                  types.String                                                 `tfsdk:"id"`
+	IdentitySourceID    types.String                                                 `tfsdk:"identity_source_id"`
+	PolicyStoreID       types.String                                                 `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String                                                 `tfsdk:"principal_entity_type"`
+}
+
+type identitySourceConfiguration struct {
+	CognitoUserPoolConfiguration fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfiguration] `tfsdk:"cognito_user_pool_configuration"`
+}
+
+type cognitoUserPoolConfiguration struct {
+	ClientIDs   types.List  `tfsdk:"client_ids"`
+	UserPoolARN fwtypes.ARN `tfsdk:"user_pool_arn"`
+}
+
+func findIdentitySourceByID(ctx context.Context, conn *verifiedpermissions.Client, policyStoreId, id string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	in := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(id),
+		PolicyStoreId:    aws.String(policyStoreId),
+	}
+
+	out, err := conn.GetIdentitySource(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdentitySourceId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func identitySourceParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%s), expected POLICY-STORE-ID:IDENTITY-SOURCE-ID", id)
+}
+
+func expandCognitoUserPoolConfiguration(ctx context.Context, list fwtypes.ListNestedObjectValueOf[identitySourceConfiguration], diags *diag.Diagnostics) *awstypes.CognitoUserPoolConfiguration {
+	configuration, d := list.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || configuration == nil {
+		return nil
+	}
+
+	cognito, d := configuration.CognitoUserPoolConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || cognito == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.CognitoUserPoolConfiguration{
+		UserPoolArn: flex.StringFromFramework(ctx, cognito.UserPoolARN),
+	}
+
+	if !cognito.ClientIDs.IsUnknown() {
+		apiObject.ClientIds = flex.ExpandFrameworkStringValueList(ctx, cognito.ClientIDs)
+	}
+
+	return apiObject
+}
+
+func flattenIdentitySourceConfiguration(ctx context.Context, apiObject *awstypes.IdentitySourceDetails) fwtypes.ListNestedObjectValueOf[identitySourceConfiguration] {
+	if apiObject == nil || apiObject.UserPoolArn == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[identitySourceConfiguration](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, &identitySourceConfiguration{
+		CognitoUserPoolConfiguration: fwtypes.NewListNestedObjectValueOfPtr(ctx, &cognitoUserPoolConfiguration{
+			ClientIDs:   flex.FlattenFrameworkStringValueListLegacy(ctx, apiObject.ClientIds),
+			UserPoolARN: flex.StringToFrameworkARN(ctx, apiObject.UserPoolArn),
+		}),
+	})
+}
@@ -0,0 +1,513 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy")
+func newResourcePolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePolicy{}
+
+	return r, nil
+}
+
+const (
+	ResNamePolicy = "Policy"
+)
+
+type resourcePolicy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (r *resourcePolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[entityIdentifier](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"entity_id": schema.StringAttribute{
+					Required: true,
+				},
+				"entity_type": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDefinition](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"static": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[staticPolicyDefinition](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("static"),
+									path.MatchRelative().AtParent().AtName("template_linked"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"description": schema.StringAttribute{
+										Optional: true,
+									},
+									"statement": schema.StringAttribute{
+										CustomType: cedarStatementType{},
+										Required:   true,
+									},
+								},
+							},
+						},
+						"template_linked": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateLinkedPolicyDefinition](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"policy_template_id": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"principal": entityIdentifierBlock,
+									"resource":  entityIdentifierBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourcePolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourcePolicyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    expandPolicyDefinition(ctx, plan.Definition, &response.Diagnostics),
+		PolicyStoreId: flex.StringFromFramework(ctx, plan.PolicyStoreID),
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicy, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringValueToFramework(ctx, fmt.Sprintf("%s:%s", aws.ToString(output.PolicyStoreId), aws.ToString(output.PolicyId)))
+	state.CreatedDate = fwtypes.TimestampValue(aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	state.PolicyID = flex.StringToFramework(ctx, output.PolicyId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, policyID, err := policyParseID(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findPolicyByID(ctx, conn, policyStoreID, policyID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.CreatedDate = fwtypes.TimestampValue(aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	state.Definition = flattenPolicyDefinition(ctx, output.Definition, state.Definition, &response.Diagnostics)
+	state.PolicyID = flex.StringToFramework(ctx, output.PolicyId)
+	state.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only static policies can be updated in place; template-linked policy changes force replacement.
+	if !plan.Definition.Equal(state.Definition) {
+		definition, diags := plan.Definition.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		static, diags := definition.Static.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if static != nil && !staticPolicyDefinitionEquivalent(ctx, static, state.Definition) {
+			input := &verifiedpermissions.UpdatePolicyInput{
+				Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+					Value: awstypes.UpdateStaticPolicyDefinition{
+						Description: flex.StringFromFramework(ctx, static.Description),
+						Statement:   flex.StringFromFramework(ctx, static.Statement),
+					},
+				},
+				PolicyId:      flex.StringFromFramework(ctx, state.PolicyID),
+				PolicyStoreId: flex.StringFromFramework(ctx, state.PolicyStoreID),
+			}
+
+			_, err := conn.UpdatePolicy(ctx, input)
+
+			if err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicy, state.ID.ValueString(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Verified Permissions Policy", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	input := &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(state.PolicyID.ValueString()),
+		PolicyStoreId: aws.String(state.PolicyStoreID.ValueString()),
+	}
+
+	_, err := conn.DeletePolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNamePolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type resourcePolicyData struct {
+	CreatedDate   fwtypes.Timestamp                                 `tfsdk:"created_date"`
+	Definition    fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
+	ID            types.String                                      `tfsdk:"id"`
+	PolicyID      types.String                                      `tfsdk:"policy_id"`
+	PolicyStoreID types.String                                      `tfsdk:"policy_store_id"`
+}
+
+type policyDefinition struct {
+	Static         fwtypes.ListNestedObjectValueOf[staticPolicyDefinition]         `tfsdk:"static"`
+	TemplateLinked fwtypes.ListNestedObjectValueOf[templateLinkedPolicyDefinition] `tfsdk:"template_linked"`
+}
+
+type staticPolicyDefinition struct {
+	Description types.String   `tfsdk:"description"`
+	Statement   cedarStatement `tfsdk:"statement"`
+}
+
+type templateLinkedPolicyDefinition struct {
+	PolicyTemplateID types.String                                      `tfsdk:"policy_template_id"`
+	Principal        fwtypes.ListNestedObjectValueOf[entityIdentifier] `tfsdk:"principal"`
+	Resource         fwtypes.ListNestedObjectValueOf[entityIdentifier] `tfsdk:"resource"`
+}
+
+type entityIdentifier struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+// staticPolicyDefinitionEquivalent returns whether the static policy definition differs from the one in
+// the specified state only in the formatting of its statement.
+func staticPolicyDefinitionEquivalent(ctx context.Context, static *staticPolicyDefinition, state fwtypes.ListNestedObjectValueOf[policyDefinition]) bool {
+	definition, diags := state.ToPtr(ctx)
+	if diags.HasError() || definition == nil {
+		return false
+	}
+
+	old, diags := definition.Static.ToPtr(ctx)
+	if diags.HasError() || old == nil {
+		return false
+	}
+
+	if !static.Description.Equal(old.Description) {
+		return false
+	}
+
+	equal, diags := old.Statement.StringSemanticEquals(ctx, static.Statement)
+
+	return !diags.HasError() && equal
+}
+
+func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, policyStoreId, id string) (*verifiedpermissions.GetPolicyOutput, error) {
+	in := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(id),
+		PolicyStoreId: aws.String(policyStoreId),
+	}
+
+	out, err := conn.GetPolicy(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PolicyId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func policyParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%s), expected POLICY-STORE-ID:POLICY-ID", id)
+}
+
+func expandPolicyDefinition(ctx context.Context, list fwtypes.ListNestedObjectValueOf[policyDefinition], diags *diag.Diagnostics) awstypes.PolicyDefinition {
+	definition, d := list.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || definition == nil {
+		return nil
+	}
+
+	static, d := definition.Static.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if static != nil {
+		return &awstypes.PolicyDefinitionMemberStatic{
+			Value: awstypes.StaticPolicyDefinition{
+				Description: flex.StringFromFramework(ctx, static.Description),
+				Statement:   flex.StringFromFramework(ctx, static.Statement),
+			},
+		}
+	}
+
+	templateLinked, d := definition.TemplateLinked.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || templateLinked == nil {
+		return nil
+	}
+
+	return &awstypes.PolicyDefinitionMemberTemplateLinked{
+		Value: awstypes.TemplateLinkedPolicyDefinition{
+			PolicyTemplateId: flex.StringFromFramework(ctx, templateLinked.PolicyTemplateID),
+			Principal:        expandEntityIdentifier(ctx, templateLinked.Principal, diags),
+			Resource:         expandEntityIdentifier(ctx, templateLinked.Resource, diags),
+		},
+	}
+}
+
+func expandEntityIdentifier(ctx context.Context, list fwtypes.ListNestedObjectValueOf[entityIdentifier], diags *diag.Diagnostics) *awstypes.EntityIdentifier {
+	entity, d := list.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || entity == nil {
+		return nil
+	}
+
+	return &awstypes.EntityIdentifier{
+		EntityId:   flex.StringFromFramework(ctx, entity.EntityID),
+		EntityType: flex.StringFromFramework(ctx, entity.EntityType),
+	}
+}
+
+// flattenPolicyDefinition converts the API policy definition to its framework value.
+func flattenPolicyDefinition(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail, prior fwtypes.ListNestedObjectValueOf[policyDefinition], diags *diag.Diagnostics) fwtypes.ListNestedObjectValueOf[policyDefinition] {
+	definition := &policyDefinition{
+		Static:         fwtypes.NewListNestedObjectValueOfNull[staticPolicyDefinition](ctx),
+		TemplateLinked: fwtypes.NewListNestedObjectValueOfNull[templateLinkedPolicyDefinition](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		definition.Static = fwtypes.NewListNestedObjectValueOfPtr(ctx, &staticPolicyDefinition{
+			Description: flex.StringToFramework(ctx, v.Value.Description),
+			Statement:   cedarStatementValue(aws.ToString(v.Value.Statement)),
+		})
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		definition.TemplateLinked = fwtypes.NewListNestedObjectValueOfPtr(ctx, &templateLinkedPolicyDefinition{
+			PolicyTemplateID: flex.StringToFramework(ctx, v.Value.PolicyTemplateId),
+			Principal:        flattenEntityIdentifier(ctx, v.Value.Principal),
+			Resource:         flattenEntityIdentifier(ctx, v.Value.Resource),
+		})
+	default:
+		diags.AddError("unexpected policy definition type", fmt.Sprintf("%T", v))
+		return prior
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, definition)
+}
+
+func flattenEntityIdentifier(ctx context.Context, apiObject *awstypes.EntityIdentifier) fwtypes.ListNestedObjectValueOf[entityIdentifier] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[entityIdentifier](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, &entityIdentifier{
+		EntityID:   flex.StringToFramework(ctx, apiObject.EntityId),
+		EntityType: flex.StringToFramework(ctx, apiObject.EntityType),
+	})
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNormalizeCedarStatement(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "single-line",
+			input:    `permit (principal, action == Action::"view", resource in Album::"test_album");`,
+			expected: `permit(principal,action == Action::"view",resource in Album::"test_album");`,
+		},
+		{
+			name:     "leading and trailing whitespace",
+			input:    "\n  permit (principal, action, resource);\n",
+			expected: `permit(principal,action,resource);`,
+		},
+		{
+			name:     "multi-line",
+			input:    "permit (\n  principal,\n  action == Action::\"view\",\n  resource in Album::\"test_album\"\n);\n",
+			expected: `permit(principal,action == Action::"view",resource in Album::"test_album");`,
+		},
+		{
+			name: "multi-line with condition",
+			input: `permit (
+  principal,
+  action == Action::"view",
+  resource
+)
+when { resource.IsPublic };`,
+			expected: `permit(principal,action == Action::"view",resource)when { resource.IsPublic };`,
+		},
+		{
+			name:     "whitespace inside string literal preserved",
+			input:    `permit (principal == User::"a  b", action, resource);`,
+			expected: `permit(principal == User::"a  b",action,resource);`,
+		},
+		{
+			name:     "escaped quote inside string literal",
+			input:    `permit (principal == User::"a\"  b",   action, resource);`,
+			expected: `permit(principal == User::"a\"  b",action,resource);`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfverifiedpermissions.NormalizeCedarStatement(testCase.input), testCase.expected; got != want {
+				t.Errorf("NormalizeCedarStatement(%q) = %q, want %q", testCase.input, got, want)
+			}
+		})
+	}
+}
+
+func TestAccVerifiedPermissionsPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_static(`permit (principal, action == Action::"view", resource in Album::"test_album");`, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", `permit (principal, action == Action::"view", resource in Album::"test_album");`),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_static(`permit (principal, action == Action::"view", resource in Album::"test_album");`, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", `permit (principal, action == Action::"view", resource in Album::"test_album");`),
+				),
+			},
+			{
+				Config: testAccPolicyConfig_static("permit (\n  principal,\n  action == Action::\"view\",\n  resource in Album::\"test_album\"\n);\n", "test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					testAccCheckPolicyStatement(&policy, `permit (principal, action == Action::"view", resource in Album::"test_album");`),
+				),
+			},
+			{
+				Config: testAccPolicyConfig_static(`permit (principal, action in [Action::"view", Action::"edit"], resource in Album::"test_album");`, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", `permit (principal, action in [Action::"view", Action::"edit"], resource in Album::"test_album");`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_templateLinked(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_templateLinked("alice"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.template_linked.0.policy_template_id", "aws_verifiedpermissions_policy_template.test", "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_type", "User"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_id", "test_album"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_type", "Album"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_templateLinked("bob"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_static(`permit (principal, action == Action::"view", resource in Album::"test_album");`, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policy" {
+				continue
+			}
+
+			policyStoreID, policyID, err := tfverifiedpermissions.PolicyParseID(rs.Primary.ID)
+			if err != nil {
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNamePolicy, rs.Primary.ID, err)
+			}
+
+			_, err = tfverifiedpermissions.FindPolicyByID(ctx, conn, policyStoreID, policyID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNamePolicy, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyExists(ctx context.Context, name string, policy *verifiedpermissions.GetPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicy, name, errors.New("not set"))
+		}
+
+		policyStoreID, policyID, err := tfverifiedpermissions.PolicyParseID(rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicy, rs.Primary.ID, err)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+		resp, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, policyStoreID, policyID)
+
+		if err != nil {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicy, rs.Primary.ID, err)
+		}
+
+		*policy = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyStatement(policy *verifiedpermissions.GetPolicyOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		static, ok := policy.Definition.(*awstypes.PolicyDefinitionDetailMemberStatic)
+		if !ok {
+			return fmt.Errorf("expected static policy definition, got %T", policy.Definition)
+		}
+
+		if got := aws.ToString(static.Value.Statement); got != expected {
+			return fmt.Errorf("policy statement = %q, want %q", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyConfig_static(statement, description string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      description = %[2]q
+      statement   = %[1]q
+    }
+  }
+}
+`, statement, description)
+}
+
+func testAccPolicyConfig_templateLinked(principalID string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = "permit (principal == ?principal, action in PhotoFlash::Action::\"FullPhotoAccess\", resource == ?resource);"
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    template_linked {
+      policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+
+      principal {
+        entity_id   = %[1]q
+        entity_type = "User"
+      }
+
+      resource {
+        entity_id   = "test_album"
+        entity_type = "Album"
+      }
+    }
+  }
+}
+`, principalID)
+}
@@ -25,6 +25,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceIdentitySource,
+			Name:    "Identity Source",
+		},
+		{
+			Factory: newResourcePolicy,
+			Name:    "Policy",
+		},
 		{
 			Factory: newResourcePolicyStore,
 			Name:    "Policy Store",
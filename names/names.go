@@ -30,6 +30,7 @@ const (
 	AccountEndpointID                    = "account"
 	ACMEndpointID                        = "acm"
 	AMPEndpointID                        = "aps"
+	AppFabricEndpointID                  = "appfabric"
 	AppFlowEndpointID                    = "appflow"
 	AppRunnerEndpointID                  = "apprunner"
 	AthenaEndpointID                     = "athena"